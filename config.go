@@ -1,6 +1,7 @@
 package main
 
 import (
+	"log/slog"
 	"time"
 
 	"github.com/caarlos0/env/v11"
@@ -24,11 +25,200 @@ type Config struct {
 		SessionDuration     time.Duration `env:"SESSION_DURATION"`
 		AccessTokenDuration time.Duration `env:"ACCESS_TOKEN_DURATION"`
 		AcessTokenSecret    string        `env:"ACCESS_TOKEN_SECRET"`
+		AccessTokenIssuer   string        `env:"ACCESS_TOKEN_ISSUER" envDefault:"weatherapp"`
+		AccessTokenAudience string        `env:"ACCESS_TOKEN_AUDIENCE" envDefault:"weatherapp"`
+
+		// AccessTokenKeys contains HMAC secrets of at least 32 bytes by their key ids (e.g. "2025-06:secret,2025-05:secret").
+		// The key with AccessTokenKeyId is used for signing, the others only validate already issued tokens.
+		// If it is empty, AcessTokenSecret is used as the only key.
+		AccessTokenKeys  map[string]string `env:"ACCESS_TOKEN_KEYS"`
+		AccessTokenKeyId string            `env:"ACCESS_TOKEN_KEY_ID" envDefault:"default"`
+
+		// AccessTokenAlgorithm is either HS256, which uses the secrets above,
+		// or RS256/EdDSA, which use the key from AccessTokenPrivateKeyFile.
+		AccessTokenAlgorithm      string `env:"ACCESS_TOKEN_ALGORITHM" envDefault:"HS256"`
+		AccessTokenPrivateKeyFile string `env:"ACCESS_TOKEN_PRIVATE_KEY_FILE"`
+		// AccessTokenPublicKeyFiles contain public keys of retired private keys,
+		// so tokens signed by them stay valid until they expire.
+		AccessTokenPublicKeyFiles []string `env:"ACCESS_TOKEN_PUBLIC_KEY_FILES"`
+
+		PasswordMinLength     int  `env:"PASSWORD_MIN_LENGTH" envDefault:"8"`
+		PasswordRequireLower  bool `env:"PASSWORD_REQUIRE_LOWER"`
+		PasswordRequireUpper  bool `env:"PASSWORD_REQUIRE_UPPER"`
+		PasswordRequireDigit  bool `env:"PASSWORD_REQUIRE_DIGIT"`
+		PasswordRequireSymbol bool `env:"PASSWORD_REQUIRE_SYMBOL"`
+		// PasswordBreachedListFile contains breached passwords, one per line.
+		PasswordBreachedListFile string `env:"PASSWORD_BREACHED_LIST_FILE"`
+
+		// PasswordHashAlgorithm is used for new hashes, either argon2id or bcrypt.
+		// Hashes of the other one are still accepted and upgraded on login.
+		PasswordHashAlgorithm     string `env:"PASSWORD_HASH_ALGORITHM" envDefault:"argon2id"`
+		PasswordArgon2Memory      uint32 `env:"PASSWORD_ARGON2_MEMORY" envDefault:"65536"`
+		PasswordArgon2Iterations  uint32 `env:"PASSWORD_ARGON2_ITERATIONS" envDefault:"3"`
+		PasswordArgon2Parallelism uint8  `env:"PASSWORD_ARGON2_PARALLELISM" envDefault:"2"`
+		PasswordBcryptCost        int    `env:"PASSWORD_BCRYPT_COST" envDefault:"10"`
+
+		// Failed logins are counted during LoginAttemptWindow, after the limit is reached
+		// the login or IP is locked out for LoginLockout, doubling with every next failure.
+		LoginAttemptWindow      time.Duration `env:"LOGIN_ATTEMPT_WINDOW" envDefault:"15m"`
+		LoginMaxAttemptsPerUser int           `env:"LOGIN_MAX_ATTEMPTS_PER_USER" envDefault:"5"`
+		LoginMaxAttemptsPerIP   int           `env:"LOGIN_MAX_ATTEMPTS_PER_IP" envDefault:"20"`
+		LoginLockout            time.Duration `env:"LOGIN_LOCKOUT" envDefault:"30s"`
+		LoginMaxLockout         time.Duration `env:"LOGIN_MAX_LOCKOUT" envDefault:"1h"`
+
+		MaxLocationsPerUser          int `env:"MAX_LOCATIONS_PER_USER" envDefault:"20"`
+		MaxAlertSubscriptionsPerUser int `env:"MAX_ALERT_SUBSCRIPTIONS_PER_USER" envDefault:"20"`
 	} `envPrefix:"DOMAIN_"`
 
 	HTTP struct {
 		Port int `env:"PORT"`
+		// TrustedProxies are the addresses or CIDRs of the proxies, which set X-Forwarded-For
+		// (e.g. "10.0.0.0/8,192.168.1.10"). Without them the address of the peer is the IP of the client,
+		// which is shared by every client behind a load balancer.
+		TrustedProxies []string `env:"TRUSTED_PROXIES"`
 	} `envPrefix:"HTTP_"`
+
+	Weather struct {
+		Timeout time.Duration `env:"TIMEOUT" envDefault:"10s"`
+
+		// Providers are tried in the order of priority, "openweathermap" and "openmeteo" are supported.
+		// OpenWeatherMap is used with the free plan, so its forecasts have 3-hour steps and cover at most 5 days.
+		// If Blend is set, every provider is called and the medians of their values are returned.
+		Providers []string `env:"PROVIDERS" envDefault:"openweathermap,openmeteo"`
+		Blend     bool     `env:"BLEND"`
+		// A provider is skipped for BreakerCooldown after BreakerThreshold consecutive failures.
+		BreakerThreshold int           `env:"BREAKER_THRESHOLD" envDefault:"5"`
+		BreakerCooldown  time.Duration `env:"BREAKER_COOLDOWN" envDefault:"30s"`
+
+		OpenWeatherMap struct {
+			BaseURL string        `env:"BASE_URL" envDefault:"https://api.openweathermap.org"`
+			APIKey  string        `env:"API_KEY"`
+			Timeout time.Duration `env:"TIMEOUT" envDefault:"5s"`
+		} `envPrefix:"OPENWEATHERMAP_"`
+
+		OpenMeteo struct {
+			BaseURL string        `env:"BASE_URL" envDefault:"https://api.open-meteo.com"`
+			APIKey  string        `env:"API_KEY"`
+			Timeout time.Duration `env:"TIMEOUT" envDefault:"5s"`
+		} `envPrefix:"OPENMETEO_"`
+
+		// Cache keeps the data for the coordinates rounded to Precision decimal places.
+		// The data is served for StaleTTL after its TTL expired, while it is refreshed in the background.
+		Cache struct {
+			Enabled    bool          `env:"ENABLED" envDefault:"true"`
+			Precision  int           `env:"PRECISION" envDefault:"2"`
+			CurrentTTL time.Duration `env:"CURRENT_TTL" envDefault:"10m"`
+			HourlyTTL  time.Duration `env:"HOURLY_TTL" envDefault:"30m"`
+			DailyTTL   time.Duration `env:"DAILY_TTL" envDefault:"3h"`
+			StaleTTL   time.Duration `env:"STALE_TTL" envDefault:"1h"`
+		} `envPrefix:"CACHE_"`
+	} `envPrefix:"WEATHER_"`
+
+	Geo struct {
+		Nominatim struct {
+			BaseURL string `env:"BASE_URL" envDefault:"https://nominatim.openstreetmap.org"`
+			// UserAgent identifies the app, as required by the usage policy of the public instance.
+			UserAgent string        `env:"USER_AGENT" envDefault:"weatherapp"`
+			Timeout   time.Duration `env:"TIMEOUT" envDefault:"5s"`
+		} `envPrefix:"NOMINATIM_"`
+
+		// Cache keeps the results for TTL, reverse geocoding uses coordinates rounded to Precision decimal places.
+		Cache struct {
+			Enabled   bool          `env:"ENABLED" envDefault:"true"`
+			Precision int           `env:"PRECISION" envDefault:"3"`
+			TTL       time.Duration `env:"TTL" envDefault:"24h"`
+			StaleTTL  time.Duration `env:"STALE_TTL" envDefault:"168h"`
+		} `envPrefix:"CACHE_"`
+	} `envPrefix:"GEO_"`
+
+	// Alerts are evaluated every Interval, forecasts of at most Concurrency locations are requested at once.
+	Alerts struct {
+		Enabled     bool          `env:"ENABLED" envDefault:"true"`
+		Interval    time.Duration `env:"INTERVAL" envDefault:"15m"`
+		Concurrency int           `env:"CONCURRENCY" envDefault:"4"`
+	} `envPrefix:"ALERTS_"`
+
+	// Streams push the updates of the weather and the alerts to the connected clients. Every stream buffers
+	// at most BufferSize updates for a slow client and requests the weather of its locations every RefreshInterval.
+	Stream struct {
+		BufferSize      int           `env:"BUFFER_SIZE" envDefault:"64"`
+		RefreshInterval time.Duration `env:"REFRESH_INTERVAL" envDefault:"5m"`
+	} `envPrefix:"STREAM_"`
+
+	// Notifications are dispatched every Interval in batches of BatchSize, at most Concurrency of them at once.
+	// A claimed notification isn't claimed again for Lease, so it should cover the delivery of a batch.
+	// Failed deliveries are retried after RetryBackoff, doubling up to MaxRetryBackoff, until MaxAttempts are made.
+	Notifications struct {
+		Enabled         bool          `env:"ENABLED" envDefault:"true"`
+		Interval        time.Duration `env:"INTERVAL" envDefault:"10s"`
+		Lease           time.Duration `env:"LEASE" envDefault:"5m"`
+		BatchSize       int           `env:"BATCH_SIZE" envDefault:"50"`
+		Concurrency     int           `env:"CONCURRENCY" envDefault:"8"`
+		MaxAttempts     int           `env:"MAX_ATTEMPTS" envDefault:"10"`
+		RetryBackoff    time.Duration `env:"RETRY_BACKOFF" envDefault:"30s"`
+		MaxRetryBackoff time.Duration `env:"MAX_RETRY_BACKOFF" envDefault:"1h"`
+
+		// Webhook requests are retried Retries times within an attempt, after RetryBackoff doubling with every retry.
+		// AllowPrivateNetworks permits webhooks on loopback and private addresses, e.g. for local development.
+		Webhook struct {
+			Timeout              time.Duration `env:"TIMEOUT" envDefault:"5s"`
+			Retries              int           `env:"RETRIES" envDefault:"3"`
+			RetryBackoff         time.Duration `env:"RETRY_BACKOFF" envDefault:"1s"`
+			AllowPrivateNetworks bool          `env:"ALLOW_PRIVATE_NETWORKS"`
+			UserAgent            string        `env:"USER_AGENT" envDefault:"weatherapp-webhooks"`
+		} `envPrefix:"WEBHOOK_"`
+
+		// SMTP is the server for email notifications, they are disabled if Host is empty.
+		// Authentication is skipped if Username is empty, e.g. for a local mail sink.
+		SMTP struct {
+			Host     string        `env:"HOST"`
+			Port     int           `env:"PORT" envDefault:"587"`
+			Username string        `env:"USERNAME"`
+			Password string        `env:"PASSWORD"`
+			From     string        `env:"FROM" envDefault:"WeatherApp <noreply@weatherapp.local>"`
+			Timeout  time.Duration `env:"TIMEOUT" envDefault:"10s"`
+		} `envPrefix:"SMTP_"`
+	} `envPrefix:"NOTIFICATIONS_"`
+
+	// RateLimit budgets are in "<requests>/<period>" format, e.g. "100/1m", "0/1s" disables the budget.
+	RateLimit struct {
+		Enabled bool   `env:"ENABLED" envDefault:"true"`
+		PerIP   string `env:"PER_IP" envDefault:"300/1m"`
+		PerUser string `env:"PER_USER" envDefault:"120/1m"`
+		// PerRoute contains budgets by route patterns, e.g. "POST /auth/login=10/1m,POST /auth/register=5/1h".
+		PerRoute map[string]string `env:"PER_ROUTE" envKeyValSeparator:"="`
+	} `envPrefix:"RATE_LIMIT_"`
+}
+
+// redacted replaces the logged secrets, an empty secret is kept to show that it isn't set.
+const redacted = "[REDACTED]"
+
+// loggedConfig has no methods, so the redacted copy is logged as is.
+type loggedConfig Config
+
+// LogValue implements slog.LogValuer, so the secrets aren't written to the logs.
+func (c Config) LogValue() slog.Value {
+	redact := func(secret *string) {
+		if *secret != "" {
+			*secret = redacted
+		}
+	}
+
+	redact(&c.Postgres.Password)
+	redact(&c.Redis.Password)
+	redact(&c.Domain.AcessTokenSecret)
+	redact(&c.Weather.OpenWeatherMap.APIKey)
+	redact(&c.Weather.OpenMeteo.APIKey)
+	redact(&c.Notifications.SMTP.Password)
+
+	// The map is shared with the original config, so the key ids are copied to a new one.
+	keys := make(map[string]string, len(c.Domain.AccessTokenKeys))
+	for id := range c.Domain.AccessTokenKeys {
+		keys[id] = redacted
+	}
+	c.Domain.AccessTokenKeys = keys
+
+	return slog.AnyValue(loggedConfig(c))
 }
 
 func LoadConfig() (Config, error) {
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS user_preferences (
+    user_id UUID PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    units VARCHAR(16) NOT NULL,
+    wind_speed_unit VARCHAR(16) NOT NULL,
+    time_zone VARCHAR(64) NOT NULL,
+    language VARCHAR(35) NOT NULL,
+    clock VARCHAR(8) NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE user_preferences;
+-- +goose StatementEnd
@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS alert_subscriptions (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    location_id UUID NOT NULL REFERENCES locations (id) ON DELETE CASCADE,
+    metric VARCHAR(32) NOT NULL,
+    operator VARCHAR(8) NOT NULL,
+    threshold DOUBLE PRECISION NOT NULL,
+    period VARCHAR(16) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX IF NOT EXISTS alert_subscriptions_user_id_idx ON alert_subscriptions (user_id);
+CREATE INDEX IF NOT EXISTS alert_subscriptions_location_id_idx ON alert_subscriptions (location_id);
+
+CREATE TABLE IF NOT EXISTS alerts (
+    id UUID PRIMARY KEY,
+    subscription_id UUID NOT NULL REFERENCES alert_subscriptions (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    location_id UUID NOT NULL REFERENCES locations (id) ON DELETE CASCADE,
+    metric VARCHAR(32) NOT NULL,
+    operator VARCHAR(8) NOT NULL,
+    threshold DOUBLE PRECISION NOT NULL,
+    value DOUBLE PRECISION NOT NULL,
+    forecast_time TIMESTAMPTZ NOT NULL,
+    day DATE NOT NULL,
+    triggered_at TIMESTAMPTZ NOT NULL,
+    -- Repeated evaluations of the same forecast must not trigger the alert again.
+    UNIQUE (subscription_id, day)
+);
+CREATE INDEX IF NOT EXISTS alerts_user_id_triggered_at_idx ON alerts (user_id, triggered_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE alerts;
+DROP TABLE alert_subscriptions;
+-- +goose StatementEnd
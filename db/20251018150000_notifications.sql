@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS notification_settings (
+    user_id UUID PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    email VARCHAR(254) NOT NULL,
+    webhook_url VARCHAR(2048) NOT NULL,
+    webhook_secret VARCHAR(128) NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS notifications (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    kind VARCHAR(32) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    message TEXT NOT NULL,
+    data JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    delivered_channels TEXT[] NOT NULL DEFAULT '{}',
+    -- Claimed notifications are leased by moving the next attempt forward,
+    -- so the ones claimed by a crashed dispatcher are retried when the lease expires.
+    next_attempt_at TIMESTAMPTZ NOT NULL,
+    last_error TEXT NOT NULL DEFAULT '',
+    sent_at TIMESTAMPTZ,
+    failed_at TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS notifications_pending_idx ON notifications (next_attempt_at)
+    WHERE sent_at IS NULL AND failed_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE notifications;
+DROP TABLE notification_settings;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Emails are sent only to verified addresses, so the existing ones have to be verified too.
+ALTER TABLE notification_settings
+    ADD COLUMN email_verified BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN email_verification_hash VARCHAR(64) NOT NULL DEFAULT '',
+    ADD COLUMN email_verification_expires_at TIMESTAMPTZ;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE notification_settings
+    DROP COLUMN email_verification_expires_at,
+    DROP COLUMN email_verification_hash,
+    DROP COLUMN email_verified;
+-- +goose StatementEnd
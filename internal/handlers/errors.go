@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/maxdikun/weatherapp/internal/handlers/gen"
+	"github.com/maxdikun/weatherapp/internal/services"
+)
+
+// requestErrorHandler handles errors, which occurred while decoding of the request.
+func requestErrorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	writeError(w, http.StatusBadRequest, gen.Error{
+		Code:      "BAD_REQUEST",
+		Timestamp: time.Now(),
+		Message:   err.Error(),
+	})
+}
+
+// responseErrorHandler handles errors returned by the handlers and the middlewares.
+func responseErrorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	status, body := translateError(err)
+
+	var locked *services.LockedError
+	var limited *services.RateLimitError
+	switch {
+	case errors.As(err, &locked):
+		w.Header().Set("Retry-After", formatSeconds(locked.RetryAfter))
+	case errors.As(err, &limited):
+		w.Header().Set("Retry-After", formatSeconds(limited.RetryAfter))
+	}
+
+	if status == http.StatusUnauthorized {
+		if _, ok := r.Context().Value(gen.BearerAuthScopes).([]string); ok {
+			w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		}
+	}
+
+	writeError(w, status, body)
+}
+
+// translateError maps the errors of the services to the status code and the body of the response.
+func translateError(err error) (int, gen.Error) {
+	body := gen.Error{Timestamp: time.Now()}
+
+	if validationErrors := services.ValidationErrors(err); len(validationErrors) != 0 {
+		details := make(map[string]interface{})
+		for _, e := range validationErrors {
+			messages, _ := details[e.Field].([]string)
+			details[e.Field] = append(messages, e.Message)
+		}
+
+		body.Code = "VALIDATION_FAILED"
+		body.Message = "Provided data is invalid"
+		body.Details = &details
+		return http.StatusBadRequest, body
+	}
+
+	var conflict *services.ConflictError
+	var locked *services.LockedError
+	var limited *services.RateLimitError
+	var limitExceeded *services.LimitError
+	switch {
+	case errors.As(err, &conflict):
+		body.Code = "ALREADY_EXISTS"
+		body.Message = err.Error()
+		body.Details = &map[string]interface{}{"field": conflict.Field}
+		return http.StatusConflict, body
+	case errors.As(err, &limitExceeded):
+		body.Code = "LIMIT_EXCEEDED"
+		body.Message = err.Error()
+		body.Details = &map[string]interface{}{"limit": limitExceeded.Limit}
+		return http.StatusConflict, body
+	case errors.As(err, &locked):
+		body.Code = "TOO_MANY_ATTEMPTS"
+		body.Message = "Too many failed attempts, try later"
+		return http.StatusTooManyRequests, body
+	case errors.As(err, &limited):
+		body.Code = "RATE_LIMITED"
+		body.Message = "Too many requests, try later"
+		return http.StatusTooManyRequests, body
+	case errors.Is(err, services.ErrInvalidCredentials):
+		body.Code = "INVALID_CREDENTIALS"
+		body.Message = "Provided login or password is wrong"
+		return http.StatusUnauthorized, body
+	case errors.Is(err, services.ErrInvalidToken):
+		body.Code = "INVALID_TOKEN"
+		body.Message = "Provided token is missing, invalid or expired"
+		return http.StatusUnauthorized, body
+	case errors.Is(err, services.ErrSessionNotFound):
+		body.Code = "SESSION_NOT_FOUND"
+		body.Message = "Session with provided id does not exist"
+		return http.StatusNotFound, body
+	case errors.Is(err, services.ErrLocationNotFound):
+		body.Code = "LOCATION_NOT_FOUND"
+		body.Message = "Location with provided id does not exist"
+		return http.StatusNotFound, body
+	case errors.Is(err, services.ErrAlertSubscriptionNotFound):
+		body.Code = "ALERT_SUBSCRIPTION_NOT_FOUND"
+		body.Message = "Alert subscription with provided id does not exist"
+		return http.StatusNotFound, body
+	case errors.Is(err, services.ErrPlaceNotFound):
+		body.Code = "PLACE_NOT_FOUND"
+		body.Message = "Place does not exist"
+		return http.StatusNotFound, body
+	case errors.Is(err, services.ErrWeatherUnavailable):
+		body.Code = "WEATHER_UNAVAILABLE"
+		body.Message = "Weather data is unavailable, try later"
+		return http.StatusBadGateway, body
+	case errors.Is(err, services.ErrGeocoderUnavailable):
+		body.Code = "GEOCODER_UNAVAILABLE"
+		body.Message = "Geocoding is unavailable, try later"
+		return http.StatusBadGateway, body
+	case errors.Is(err, services.ErrStreamClosed):
+		body.Code = "STREAM_CLOSED"
+		body.Message = "Stream is closed by the server, reconnect later"
+		return http.StatusServiceUnavailable, body
+	case errors.Is(err, services.ErrSlowConsumer):
+		body.Code = "SLOW_CONSUMER"
+		body.Message = "Updates are not read fast enough, reconnect to get the latest data"
+		return http.StatusServiceUnavailable, body
+	}
+
+	body.Code = "INTERNAL_ERROR"
+	body.Message = "Internal service error occurred, try later"
+	return http.StatusInternalServerError, body
+}
+
+// formatSeconds formats the duration as a number of seconds, rounded up.
+func formatSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
+
+func writeError(w http.ResponseWriter, status int, body gen.Error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/maxdikun/weatherapp/internal/handlers/gen"
+	"github.com/maxdikun/weatherapp/internal/services"
+)
+
+const (
+	defaultForecastHours      = 24
+	defaultForecastDays       = 7
+	defaultPlaceSearchResults = 5
+	defaultListedAlerts       = 50
+)
+
+var hourlyForecastFields = []gen.HourlyForecastField{
+	gen.HourlyForecastFieldTemperature,
+	gen.HourlyForecastFieldFeelsLike,
+	gen.HourlyForecastFieldHumidity,
+	gen.HourlyForecastFieldPressure,
+	gen.HourlyForecastFieldWindSpeed,
+	gen.HourlyForecastFieldWindGust,
+	gen.HourlyForecastFieldWindDirection,
+	gen.HourlyForecastFieldPrecipitationProbability,
+	gen.HourlyForecastFieldPrecipitation,
+	gen.HourlyForecastFieldCondition,
+	gen.HourlyForecastFieldDescription,
+}
+
+var dailyForecastFields = []gen.DailyForecastField{
+	gen.DailyForecastFieldTemperatureMin,
+	gen.DailyForecastFieldTemperatureMax,
+	gen.DailyForecastFieldHumidity,
+	gen.DailyForecastFieldPressure,
+	gen.DailyForecastFieldWindSpeed,
+	gen.DailyForecastFieldWindGust,
+	gen.DailyForecastFieldWindDirection,
+	gen.DailyForecastFieldPrecipitationProbability,
+	gen.DailyForecastFieldPrecipitation,
+	gen.DailyForecastFieldCondition,
+	gen.DailyForecastFieldDescription,
+	gen.DailyForecastFieldSunrise,
+	gen.DailyForecastFieldSunset,
+}
+
+// fieldSet is the set of fields requested by the caller, nil set contains every field.
+type fieldSet[F ~string] map[F]struct{}
+
+// newFieldSet builds the set from the fields parameter, nil parameter selects every field.
+// The generated code doesn't check enum values, so unknown fields are reported here.
+func newFieldSet[F ~string](fields *[]F, known []F) (fieldSet[F], error) {
+	if fields == nil {
+		return nil, nil
+	}
+
+	set := make(fieldSet[F], len(*fields))
+	for _, field := range *fields {
+		if !slices.Contains(known, field) {
+			return nil, &services.ValidationError{
+				Field:   "fields",
+				Message: fmt.Sprintf("unknown field %q", field),
+			}
+		}
+		set[field] = struct{}{}
+	}
+	return set, nil
+}
+
+func (s fieldSet[F]) has(field F) bool {
+	if s == nil {
+		return true
+	}
+	_, ok := s[field]
+	return ok
+}
+
+// pick returns v if the field was requested, so zero values of requested fields are still present.
+func pick[F ~string, T any](fields fieldSet[F], field F, v T) *T {
+	if !fields.has(field) {
+		return nil
+	}
+	return &v
+}
+
+// valueOr returns the value of an optional parameter or def if it is missing.
+func valueOr[T any](v *T, def T) T {
+	if v == nil {
+		return def
+	}
+	return *v
+}
@@ -19,15 +19,255 @@ import (
 	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oapi-codegen/runtime"
 	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for AlertMetric.
+const (
+	AlertMetricFeelsLike                AlertMetric = "feelsLike"
+	AlertMetricHumidity                 AlertMetric = "humidity"
+	AlertMetricPrecipitation            AlertMetric = "precipitation"
+	AlertMetricPrecipitationProbability AlertMetric = "precipitationProbability"
+	AlertMetricTemperature              AlertMetric = "temperature"
+	AlertMetricWindGust                 AlertMetric = "windGust"
+	AlertMetricWindSpeed                AlertMetric = "windSpeed"
+)
+
+// Defines values for AlertOperator.
+const (
+	AlertOperatorAbove AlertOperator = "above"
+	AlertOperatorBelow AlertOperator = "below"
+)
+
+// Defines values for AlertPeriod.
+const (
+	AlertPeriodNext24h  AlertPeriod = "next24h"
+	AlertPeriodToday    AlertPeriod = "today"
+	AlertPeriodTomorrow AlertPeriod = "tomorrow"
+)
+
+// Defines values for ClockFormat.
+const (
+	ClockFormatN12h ClockFormat = "12h"
+	ClockFormatN24h ClockFormat = "24h"
+)
+
+// Defines values for DailyForecastField.
+const (
+	DailyForecastFieldCondition                DailyForecastField = "condition"
+	DailyForecastFieldDescription              DailyForecastField = "description"
+	DailyForecastFieldHumidity                 DailyForecastField = "humidity"
+	DailyForecastFieldPrecipitation            DailyForecastField = "precipitation"
+	DailyForecastFieldPrecipitationProbability DailyForecastField = "precipitationProbability"
+	DailyForecastFieldPressure                 DailyForecastField = "pressure"
+	DailyForecastFieldSunrise                  DailyForecastField = "sunrise"
+	DailyForecastFieldSunset                   DailyForecastField = "sunset"
+	DailyForecastFieldTemperatureMax           DailyForecastField = "temperatureMax"
+	DailyForecastFieldTemperatureMin           DailyForecastField = "temperatureMin"
+	DailyForecastFieldWindDirection            DailyForecastField = "windDirection"
+	DailyForecastFieldWindGust                 DailyForecastField = "windGust"
+	DailyForecastFieldWindSpeed                DailyForecastField = "windSpeed"
+)
+
+// Defines values for HourlyForecastField.
+const (
+	HourlyForecastFieldCondition                HourlyForecastField = "condition"
+	HourlyForecastFieldDescription              HourlyForecastField = "description"
+	HourlyForecastFieldFeelsLike                HourlyForecastField = "feelsLike"
+	HourlyForecastFieldHumidity                 HourlyForecastField = "humidity"
+	HourlyForecastFieldPrecipitation            HourlyForecastField = "precipitation"
+	HourlyForecastFieldPrecipitationProbability HourlyForecastField = "precipitationProbability"
+	HourlyForecastFieldPressure                 HourlyForecastField = "pressure"
+	HourlyForecastFieldTemperature              HourlyForecastField = "temperature"
+	HourlyForecastFieldWindDirection            HourlyForecastField = "windDirection"
+	HourlyForecastFieldWindGust                 HourlyForecastField = "windGust"
+	HourlyForecastFieldWindSpeed                HourlyForecastField = "windSpeed"
+)
+
+// Defines values for SocketCommandType.
+const (
+	SocketCommandTypeAuth        SocketCommandType = "auth"
+	SocketCommandTypeSubscribe   SocketCommandType = "subscribe"
+	SocketCommandTypeUnsubscribe SocketCommandType = "unsubscribe"
+)
+
+// Defines values for SocketMessageType.
+const (
+	SocketMessageTypeAlert         SocketMessageType = "alert"
+	SocketMessageTypeAuthenticated SocketMessageType = "authenticated"
+	SocketMessageTypeClose         SocketMessageType = "close"
+	SocketMessageTypeError         SocketMessageType = "error"
+	SocketMessageTypeSubscribed    SocketMessageType = "subscribed"
+	SocketMessageTypeUnsubscribed  SocketMessageType = "unsubscribed"
+	SocketMessageTypeWeather       SocketMessageType = "weather"
+)
+
+// Defines values for UnitSystem.
+const (
+	UnitSystemImperial UnitSystem = "imperial"
+	UnitSystemMetric   UnitSystem = "metric"
+	UnitSystemSi       UnitSystem = "si"
+)
+
+// Defines values for WeatherCondition.
+const (
+	WeatherConditionClear        WeatherCondition = "clear"
+	WeatherConditionCloudy       WeatherCondition = "cloudy"
+	WeatherConditionDrizzle      WeatherCondition = "drizzle"
+	WeatherConditionFog          WeatherCondition = "fog"
+	WeatherConditionPartlyCloudy WeatherCondition = "partly_cloudy"
+	WeatherConditionRain         WeatherCondition = "rain"
+	WeatherConditionSnow         WeatherCondition = "snow"
+	WeatherConditionThunderstorm WeatherCondition = "thunderstorm"
+	WeatherConditionUnknown      WeatherCondition = "unknown"
+)
+
+// Defines values for WindSpeedUnit.
+const (
+	WindSpeedUnitKmh   WindSpeedUnit = "kmh"
+	WindSpeedUnitKnots WindSpeedUnit = "knots"
+	WindSpeedUnitMph   WindSpeedUnit = "mph"
+	WindSpeedUnitMps   WindSpeedUnit = "mps"
+)
+
+// Alert defines model for Alert.
+type Alert struct {
+	// Day Day of forecastTime in the time zone of the user, the subscription triggers once on it
+	Day          openapi_types.Date `json:"day"`
+	ForecastTime time.Time          `json:"forecastTime"`
+	Id           openapi_types.UUID `json:"id"`
+	LocationId   openapi_types.UUID `json:"locationId"`
+
+	// Metric Value of the hourly forecast, which is compared with the threshold
+	Metric         AlertMetric        `json:"metric"`
+	Operator       AlertOperator      `json:"operator"`
+	SubscriptionId openapi_types.UUID `json:"subscriptionId"`
+	Threshold      float64            `json:"threshold"`
+	TriggeredAt    time.Time          `json:"triggeredAt"`
+
+	// Value Forecasted value at forecastTime, the first hour when the rule matched
+	Value float64 `json:"value"`
+}
+
+// AlertMetric Value of the hourly forecast, which is compared with the threshold
+type AlertMetric string
+
+// AlertOperator defines model for AlertOperator.
+type AlertOperator string
+
+// AlertPeriod Part of the forecast to check, days are in the time zone from the preferences
+type AlertPeriod string
+
+// AlertSubscription Alert is triggered at most once a day, when the forecast for the location
+// has the metric above or below the threshold during the period.
+type AlertSubscription struct {
+	CreatedAt  time.Time          `json:"createdAt"`
+	Id         openapi_types.UUID `json:"id"`
+	LocationId openapi_types.UUID `json:"locationId"`
+
+	// Metric Value of the hourly forecast, which is compared with the threshold
+	Metric   AlertMetric   `json:"metric"`
+	Operator AlertOperator `json:"operator"`
+
+	// Period Part of the forecast to check, days are in the time zone from the preferences
+	Period AlertPeriod `json:"period"`
+
+	// Threshold In °C, m/s, mm or percents depending on the metric, regardless of the preferences
+	Threshold float64 `json:"threshold"`
+}
+
+// AlertSubscriptionUpdate Only provided fields are changed.
+type AlertSubscriptionUpdate struct {
+	// Metric Value of the hourly forecast, which is compared with the threshold
+	Metric   *AlertMetric   `json:"metric,omitempty"`
+	Operator *AlertOperator `json:"operator,omitempty"`
+
+	// Period Part of the forecast to check, days are in the time zone from the preferences
+	Period    *AlertPeriod `json:"period,omitempty"`
+	Threshold *float64     `json:"threshold,omitempty"`
+}
+
+// ClockFormat defines model for ClockFormat.
+type ClockFormat string
+
 // Credentials defines model for Credentials.
 type Credentials struct {
 	Login    string `json:"login"`
 	Password string `json:"password"`
 }
 
+// CurrentWeather Values are in units from the preferences of the user, which are listed in units.
+// Humidity is in percents, times are in the time zone from the preferences.
+type CurrentWeather struct {
+	Condition   WeatherCondition `json:"condition"`
+	Description string           `json:"description"`
+	FeelsLike   float64          `json:"feelsLike"`
+	Humidity    float64          `json:"humidity"`
+	Latitude    float64          `json:"latitude"`
+	Longitude   float64          `json:"longitude"`
+	ObservedAt  time.Time        `json:"observedAt"`
+	Pressure    float64          `json:"pressure"`
+
+	// Provider Name of the provider, which returned the data, names of blended providers are joined with "+"
+	Provider    string  `json:"provider"`
+	Temperature float64 `json:"temperature"`
+
+	// Units Symbols of the units used in the weather data.
+	Units WeatherUnits `json:"units"`
+
+	// WindDirection Direction the wind comes from, in degrees
+	WindDirection float64 `json:"windDirection"`
+	WindGust      float64 `json:"windGust"`
+	WindSpeed     float64 `json:"windSpeed"`
+}
+
+// DailyForecast Values are in units from the preferences of the user, which are listed in units.
+// Humidity and probabilities are in percents, times are in the time zone from the preferences.
+type DailyForecast struct {
+	Latitude  float64               `json:"latitude"`
+	Longitude float64               `json:"longitude"`
+	Periods   []DailyForecastPeriod `json:"periods"`
+
+	// Provider Name of the provider, which returned the forecast, names of blended providers are joined with "+"
+	Provider string `json:"provider"`
+
+	// Units Symbols of the units used in the weather data.
+	Units WeatherUnits `json:"units"`
+}
+
+// DailyForecastField defines model for DailyForecastField.
+type DailyForecastField string
+
+// DailyForecastPeriod Forecast for the day, only the requested fields are present.
+type DailyForecastPeriod struct {
+	Condition                *WeatherCondition  `json:"condition,omitempty"`
+	Date                     openapi_types.Date `json:"date"`
+	Description              *string            `json:"description,omitempty"`
+	Humidity                 *float64           `json:"humidity,omitempty"`
+	Precipitation            *float64           `json:"precipitation,omitempty"`
+	PrecipitationProbability *float64           `json:"precipitationProbability,omitempty"`
+	Pressure                 *float64           `json:"pressure,omitempty"`
+	Sunrise                  *time.Time         `json:"sunrise,omitempty"`
+	Sunset                   *time.Time         `json:"sunset,omitempty"`
+	TemperatureMax           *float64           `json:"temperatureMax,omitempty"`
+	TemperatureMin           *float64           `json:"temperatureMin,omitempty"`
+	WindDirection            *float64           `json:"windDirection,omitempty"`
+	WindGust                 *float64           `json:"windGust,omitempty"`
+	WindSpeed                *float64           `json:"windSpeed,omitempty"`
+}
+
+// EmailVerification defines model for EmailVerification.
+type EmailVerification struct {
+	// Code Code from the email sent to the address
+	Code string `json:"code"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	Code      string                  `json:"code"`
@@ -36,256 +276,3720 @@ type Error struct {
 	Timestamp time.Time               `json:"timestamp"`
 }
 
-// TokenPair defines model for TokenPair.
-type TokenPair struct {
-	AccessToken           string    `json:"accessToken"`
-	RefreshToken          string    `json:"refreshToken"`
-	RefreshTokenExpiresAt time.Time `json:"refreshTokenExpiresAt"`
-}
+// HourlyForecast Values are in units from the preferences of the user, which are listed in units.
+// Humidity and probabilities are in percents, times are in the time zone from the preferences.
+type HourlyForecast struct {
+	Latitude  float64                `json:"latitude"`
+	Longitude float64                `json:"longitude"`
+	Periods   []HourlyForecastPeriod `json:"periods"`
 
-// RegisterJSONRequestBody defines body for Register for application/json ContentType.
-type RegisterJSONRequestBody = Credentials
+	// Provider Name of the provider, which returned the forecast, names of blended providers are joined with "+"
+	Provider string `json:"provider"`
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Register a new user account
-	// (POST /auth/register)
-	Register(w http.ResponseWriter, r *http.Request)
+	// Units Symbols of the units used in the weather data.
+	Units WeatherUnits `json:"units"`
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
-}
+// HourlyForecastField defines model for HourlyForecastField.
+type HourlyForecastField string
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// HourlyForecastPeriod Forecast for the hour starting at time, only the requested fields are present.
+type HourlyForecastPeriod struct {
+	Condition                *WeatherCondition `json:"condition,omitempty"`
+	Description              *string           `json:"description,omitempty"`
+	FeelsLike                *float64          `json:"feelsLike,omitempty"`
+	Humidity                 *float64          `json:"humidity,omitempty"`
+	Precipitation            *float64          `json:"precipitation,omitempty"`
+	PrecipitationProbability *float64          `json:"precipitationProbability,omitempty"`
+	Pressure                 *float64          `json:"pressure,omitempty"`
+	Temperature              *float64          `json:"temperature,omitempty"`
+	Time                     time.Time         `json:"time"`
+	WindDirection            *float64          `json:"windDirection,omitempty"`
+	WindGust                 *float64          `json:"windGust,omitempty"`
+	WindSpeed                *float64          `json:"windSpeed,omitempty"`
+}
 
-// Register operation middleware
-func (siw *ServerInterfaceWrapper) Register(w http.ResponseWriter, r *http.Request) {
+// JWK defines model for JWK.
+type JWK struct {
+	Alg string  `json:"alg"`
+	Crv *string `json:"crv,omitempty"`
+	E   *string `json:"e,omitempty"`
+	Kid string  `json:"kid"`
+	Kty string  `json:"kty"`
+	N   *string `json:"n,omitempty"`
+	Use string  `json:"use"`
+	X   *string `json:"x,omitempty"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Register(w, r)
-	}))
+// JWKSet defines model for JWKSet.
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// Location defines model for Location.
+type Location struct {
+	CreatedAt time.Time          `json:"createdAt"`
+	Id        openapi_types.UUID `json:"id"`
+	Latitude  float64            `json:"latitude"`
+	Longitude float64            `json:"longitude"`
+	Name      string             `json:"name"`
 
-	handler.ServeHTTP(w, r)
+	// Position Locations are ordered by position
+	Position int `json:"position"`
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
+// LocationOrder defines model for LocationOrder.
+type LocationOrder struct {
+	// Ids Ids of every saved location in the new order
+	Ids []openapi_types.UUID `json:"ids"`
 }
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+// LocationUpdate Only provided fields are changed, placeId replaces both coordinates.
+type LocationUpdate struct {
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+	Name      *string  `json:"name,omitempty"`
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
+	// PlaceId Id of a place from geo search
+	PlaceId *string `json:"placeId,omitempty"`
 }
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+// NewAlertSubscription defines model for NewAlertSubscription.
+type NewAlertSubscription struct {
+	LocationId openapi_types.UUID `json:"locationId"`
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	// Metric Value of the hourly forecast, which is compared with the threshold
+	Metric   AlertMetric   `json:"metric"`
+	Operator AlertOperator `json:"operator"`
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
+	// Period Part of the forecast to check, days are in the time zone from the preferences
+	Period AlertPeriod `json:"period"`
+
+	// Threshold In °C, m/s, mm or percents depending on the metric, regardless of the preferences
+	Threshold float64 `json:"threshold"`
 }
 
-type RequiredParamError struct {
-	ParamName string
+// NewLocation Location is placed either by latitude and longitude or by placeId.
+type NewLocation struct {
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+	Name      string   `json:"name"`
+
+	// PlaceId Id of a place from geo search
+	PlaceId *string `json:"placeId,omitempty"`
 }
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+// NotificationSettings Notifications about triggered alerts, new logins and password changes are sent to every enabled channel.
+//
+// Webhooks receive POST requests with JSON body containing id, kind, title, message, data and createdAt.
+// The request has headers X-Webhook-Id, X-Webhook-Timestamp (Unix seconds) and X-Webhook-Signature,
+// which is "sha256=" followed by hex encoded HMAC-SHA256 of the timestamp, a dot and the body, keyed with webhookSecret.
+// A notification can be delivered more than once, duplicates have the same id.
+type NotificationSettings struct {
+	// Email Address for email notifications, empty if they are disabled
+	Email string `json:"email"`
+
+	// EmailVerified Whether the email is verified. A code is sent to every new email, and notifications are sent
+	// to it only after the code is confirmed with VerifyNotificationEmail.
+	EmailVerified bool `json:"emailVerified"`
+
+	// WebhookSecret Secret of the webhook signatures, a new one is generated whenever webhookUrl changes
+	WebhookSecret string `json:"webhookSecret"`
+
+	// WebhookUrl URL for webhook notifications, empty if they are disabled
+	WebhookUrl string `json:"webhookUrl"`
 }
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
+// NotificationSettingsUpdate Only provided fields are changed, empty strings disable the channels.
+// Setting the unverified email again sends a new verification code.
+type NotificationSettingsUpdate struct {
+	Email      *string `json:"email,omitempty"`
+	WebhookUrl *string `json:"webhookUrl,omitempty"`
 }
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+// PasswordChange defines model for PasswordChange.
+type PasswordChange struct {
+	CurrentPassword string `json:"currentPassword"`
+	NewPassword     string `json:"newPassword"`
 }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
+// Place defines model for Place.
+type Place struct {
+	Country string `json:"country"`
+
+	// CountryCode ISO 3166-1 alpha-2 code of the country
+	CountryCode string `json:"countryCode"`
+
+	// DisplayName Full name of the place with its address
+	DisplayName string `json:"displayName"`
+
+	// Id Can be used instead of coordinates in weather requests and saved locations
+	Id        string  `json:"id"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Name      string  `json:"name"`
 }
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+// Preferences defines model for Preferences.
+type Preferences struct {
+	Clock ClockFormat `json:"clock"`
+
+	// Language BCP 47 language tag
+	Language string `json:"language"`
+
+	// TimeZone IANA name of the time zone, times in weather data are returned in it
+	TimeZone string `json:"timeZone"`
+
+	// Units Units of temperatures, pressure and precipitation:
+	// metric is °C, hPa and mm, imperial is °F, inHg and in, si is K, Pa and mm.
+	Units         UnitSystem    `json:"units"`
+	WindSpeedUnit WindSpeedUnit `json:"windSpeedUnit"`
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+// PreferencesUpdate Only provided fields are changed.
+type PreferencesUpdate struct {
+	Clock *ClockFormat `json:"clock,omitempty"`
+
+	// Language BCP 47 language tag, e.g. en-US
+	Language *string `json:"language,omitempty"`
+
+	// TimeZone IANA name of the time zone, e.g. Europe/Berlin
+	TimeZone *string `json:"timeZone,omitempty"`
+
+	// Units Units of temperatures, pressure and precipitation:
+	// metric is °C, hPa and mm, imperial is °F, inHg and in, si is K, Pa and mm.
+	Units         *UnitSystem    `json:"units,omitempty"`
+	WindSpeedUnit *WindSpeedUnit `json:"windSpeedUnit,omitempty"`
 }
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+// RefreshRequest defines model for RefreshRequest.
+type RefreshRequest struct {
+	RefreshToken string `json:"refreshToken"`
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
+// Session defines model for Session.
+type Session struct {
+	CreatedAt   time.Time          `json:"createdAt"`
+	ExpiresAt   time.Time          `json:"expiresAt"`
+	Id          openapi_types.UUID `json:"id"`
+	Ip          string             `json:"ip"`
+	RefreshedAt time.Time          `json:"refreshedAt"`
+	UserAgent   string             `json:"userAgent"`
 }
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+// SocketCommand defines model for SocketCommand.
+type SocketCommand struct {
+	// AccessToken Fresh access token of auth
+	AccessToken *string `json:"accessToken,omitempty"`
+
+	// Id Returned in the reply to the command
+	Id *string `json:"id,omitempty"`
+
+	// LocationId Location of subscribe and unsubscribe
+	LocationId *openapi_types.UUID `json:"locationId,omitempty"`
+	Type       SocketCommandType   `json:"type"`
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{})
+// SocketCommandType defines model for SocketCommand.Type.
+type SocketCommandType string
+
+// SocketMessage defines model for SocketMessage.
+type SocketMessage struct {
+	Alert *Alert `json:"alert,omitempty"`
+	Error *Error `json:"error,omitempty"`
+
+	// ExpiresAt Expiration time of the access token of authenticated
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Id Id of the command, which the message replies to
+	Id       *string   `json:"id,omitempty"`
+	Location *Location `json:"location,omitempty"`
+
+	// LocationId Location of unsubscribed
+	LocationId *openapi_types.UUID `json:"locationId,omitempty"`
+	Type       SocketMessageType   `json:"type"`
+
+	// Weather Values are in units from the preferences of the user, which are listed in units.
+	// Humidity is in percents, times are in the time zone from the preferences.
+	Weather *CurrentWeather `json:"weather,omitempty"`
 }
 
-// ServeMux is an abstraction of http.ServeMux.
-type ServeMux interface {
-	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
-	ServeHTTP(w http.ResponseWriter, r *http.Request)
+// SocketMessageType defines model for SocketMessage.Type.
+type SocketMessageType string
+
+// StreamAlert defines model for StreamAlert.
+type StreamAlert struct {
+	Alert    Alert    `json:"alert"`
+	Location Location `json:"location"`
 }
 
-type StdHTTPServerOptions struct {
-	BaseURL          string
-	BaseRouter       ServeMux
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+// StreamWeather defines model for StreamWeather.
+type StreamWeather struct {
+	Location Location `json:"location"`
+
+	// Weather Values are in units from the preferences of the user, which are listed in units.
+	// Humidity is in percents, times are in the time zone from the preferences.
+	Weather CurrentWeather `json:"weather"`
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseRouter: m,
-	})
+// TokenPair defines model for TokenPair.
+type TokenPair struct {
+	AccessToken           string    `json:"accessToken"`
+	RefreshToken          string    `json:"refreshToken"`
+	RefreshTokenExpiresAt time.Time `json:"refreshTokenExpiresAt"`
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: m,
-	})
+// UnitSystem Units of temperatures, pressure and precipitation:
+// metric is °C, hPa and mm, imperial is °F, inHg and in, si is K, Pa and mm.
+type UnitSystem string
+
+// WeatherCondition defines model for WeatherCondition.
+type WeatherCondition string
+
+// WeatherUnits Symbols of the units used in the weather data.
+type WeatherUnits struct {
+	Precipitation string `json:"precipitation"`
+	Pressure      string `json:"pressure"`
+	Temperature   string `json:"temperature"`
+	WindSpeed     string `json:"windSpeed"`
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
-	m := options.BaseRouter
+// WindSpeedUnit defines model for WindSpeedUnit.
+type WindSpeedUnit string
 
-	if m == nil {
-		m = http.NewServeMux()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
+// Latitude defines model for Latitude.
+type Latitude = float64
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+// LocationId defines model for LocationId.
+type LocationId = openapi_types.UUID
 
-	m.HandleFunc("POST "+options.BaseURL+"/auth/register", wrapper.Register)
+// Longitude defines model for Longitude.
+type Longitude = float64
 
-	return m
+// PlaceId defines model for PlaceId.
+type PlaceId = string
+
+// Units Units of temperatures, pressure and precipitation:
+// metric is °C, hPa and mm, imperial is °F, inHg and in, si is K, Pa and mm.
+type Units = UnitSystem
+
+// ReverseGeocodeParams defines parameters for ReverseGeocode.
+type ReverseGeocodeParams struct {
+	Lat float64 `form:"lat" json:"lat"`
+	Lon float64 `form:"lon" json:"lon"`
 }
 
-type RegisterRequestObject struct {
-	Body *RegisterJSONRequestBody
+// SearchPlacesParams defines parameters for SearchPlaces.
+type SearchPlacesParams struct {
+	// Q Name or address of the place
+	Q     string `form:"q" json:"q"`
+	Limit *int   `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-type RegisterResponseObject interface {
-	VisitRegisterResponse(w http.ResponseWriter) error
+// ListAlertsParams defines parameters for ListAlerts.
+type ListAlertsParams struct {
+	// Limit Maximum number of alerts to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-type Register200JSONResponse TokenPair
+// GetCurrentWeatherParams defines parameters for GetCurrentWeather.
+type GetCurrentWeatherParams struct {
+	// Lat Required with lon, unless location or place is provided
+	Lat *Latitude `form:"lat,omitempty" json:"lat,omitempty"`
 
-func (response Register200JSONResponse) VisitRegisterResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// Lon Required with lat, unless location or place is provided
+	Lon *Longitude `form:"lon,omitempty" json:"lon,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Location Id of a saved location to use instead of lat and lon
+	Location *LocationId `form:"location,omitempty" json:"location,omitempty"`
 
-type Register400JSONResponse Error
+	// Place Id of a place from geo search to use instead of lat and lon
+	Place *PlaceId `form:"place,omitempty" json:"place,omitempty"`
 
-func (response Register400JSONResponse) VisitRegisterResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// Units Unit system to use instead of the one from the preferences
+	Units *Units `form:"units,omitempty" json:"units,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	// WindSpeedUnit Unit of wind speeds to use instead of the one from the preferences
+	WindSpeedUnit *WindSpeedUnit `form:"windSpeedUnit,omitempty" json:"windSpeedUnit,omitempty"`
 }
 
-type Register409JSONResponse Error
+// GetDailyForecastParams defines parameters for GetDailyForecast.
+type GetDailyForecastParams struct {
+	// Lat Required with lon, unless location or place is provided
+	Lat *Latitude `form:"lat,omitempty" json:"lat,omitempty"`
 
-func (response Register409JSONResponse) VisitRegisterResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	// Lon Required with lat, unless location or place is provided
+	Lon *Longitude `form:"lon,omitempty" json:"lon,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Location Id of a saved location to use instead of lat and lon
+	Location *LocationId `form:"location,omitempty" json:"location,omitempty"`
 
-type Register500JSONResponse Error
+	// Place Id of a place from geo search to use instead of lat and lon
+	Place *PlaceId `form:"place,omitempty" json:"place,omitempty"`
 
-func (response Register500JSONResponse) VisitRegisterResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// Units Unit system to use instead of the one from the preferences
+	Units *Units `form:"units,omitempty" json:"units,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// WindSpeedUnit Unit of wind speeds to use instead of the one from the preferences
+	WindSpeedUnit *WindSpeedUnit `form:"windSpeedUnit,omitempty" json:"windSpeedUnit,omitempty"`
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Register a new user account
-	// (POST /auth/register)
-	Register(ctx context.Context, request RegisterRequestObject) (RegisterResponseObject, error)
+	// Periods Number of days to return
+	Periods *int `form:"periods,omitempty" json:"periods,omitempty"`
+
+	// Fields Fields of the periods to return, all of them by default
+	Fields *[]DailyForecastField `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// GetHourlyForecastParams defines parameters for GetHourlyForecast.
+type GetHourlyForecastParams struct {
+	// Lat Required with lon, unless location or place is provided
+	Lat *Latitude `form:"lat,omitempty" json:"lat,omitempty"`
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	// Lon Required with lat, unless location or place is provided
+	Lon *Longitude `form:"lon,omitempty" json:"lon,omitempty"`
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	// Location Id of a saved location to use instead of lat and lon
+	Location *LocationId `form:"location,omitempty" json:"location,omitempty"`
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	// Place Id of a place from geo search to use instead of lat and lon
+	Place *PlaceId `form:"place,omitempty" json:"place,omitempty"`
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
-}
+	// Units Unit system to use instead of the one from the preferences
+	Units *Units `form:"units,omitempty" json:"units,omitempty"`
+
+	// WindSpeedUnit Unit of wind speeds to use instead of the one from the preferences
+	WindSpeedUnit *WindSpeedUnit `form:"windSpeedUnit,omitempty" json:"windSpeedUnit,omitempty"`
+
+	// Periods Number of hours to return
+	Periods *int `form:"periods,omitempty" json:"periods,omitempty"`
+
+	// Fields Fields of the periods to return, all of them by default
+	Fields *[]HourlyForecastField `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// LoginJSONRequestBody defines body for Login for application/json ContentType.
+type LoginJSONRequestBody = Credentials
+
+// LogoutJSONRequestBody defines body for Logout for application/json ContentType.
+type LogoutJSONRequestBody = RefreshRequest
+
+// LogoutAllJSONRequestBody defines body for LogoutAll for application/json ContentType.
+type LogoutAllJSONRequestBody = RefreshRequest
+
+// RefreshJSONRequestBody defines body for Refresh for application/json ContentType.
+type RefreshJSONRequestBody = RefreshRequest
+
+// RegisterJSONRequestBody defines body for Register for application/json ContentType.
+type RegisterJSONRequestBody = Credentials
+
+// AddAlertSubscriptionJSONRequestBody defines body for AddAlertSubscription for application/json ContentType.
+type AddAlertSubscriptionJSONRequestBody = NewAlertSubscription
+
+// UpdateAlertSubscriptionJSONRequestBody defines body for UpdateAlertSubscription for application/json ContentType.
+type UpdateAlertSubscriptionJSONRequestBody = AlertSubscriptionUpdate
+
+// AddLocationJSONRequestBody defines body for AddLocation for application/json ContentType.
+type AddLocationJSONRequestBody = NewLocation
+
+// ReorderLocationsJSONRequestBody defines body for ReorderLocations for application/json ContentType.
+type ReorderLocationsJSONRequestBody = LocationOrder
+
+// UpdateLocationJSONRequestBody defines body for UpdateLocation for application/json ContentType.
+type UpdateLocationJSONRequestBody = LocationUpdate
+
+// UpdateNotificationSettingsJSONRequestBody defines body for UpdateNotificationSettings for application/json ContentType.
+type UpdateNotificationSettingsJSONRequestBody = NotificationSettingsUpdate
+
+// VerifyNotificationEmailJSONRequestBody defines body for VerifyNotificationEmail for application/json ContentType.
+type VerifyNotificationEmailJSONRequestBody = EmailVerification
+
+// ChangePasswordJSONRequestBody defines body for ChangePassword for application/json ContentType.
+type ChangePasswordJSONRequestBody = PasswordChange
+
+// UpdatePreferencesJSONRequestBody defines body for UpdatePreferences for application/json ContentType.
+type UpdatePreferencesJSONRequestBody = PreferencesUpdate
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Public keys to validate access tokens
+	// (GET /.well-known/jwks.json)
+	GetJWKS(w http.ResponseWriter, r *http.Request)
+	// Authenticate an existing user
+	// (POST /auth/login)
+	Login(w http.ResponseWriter, r *http.Request)
+	// Revoke the session of the provided refresh token
+	// (POST /auth/logout)
+	Logout(w http.ResponseWriter, r *http.Request)
+	// Revoke every session of the user owning the refresh token
+	// (POST /auth/logout-all)
+	LogoutAll(w http.ResponseWriter, r *http.Request)
+	// Exchange a refresh token for a new token pair
+	// (POST /auth/refresh)
+	Refresh(w http.ResponseWriter, r *http.Request)
+	// Register a new user account
+	// (POST /auth/register)
+	Register(w http.ResponseWriter, r *http.Request)
+	// Find the place at the coordinates
+	// (GET /geo/reverse)
+	ReverseGeocode(w http.ResponseWriter, r *http.Request, params ReverseGeocodeParams)
+	// Find places by name
+	// (GET /geo/search)
+	SearchPlaces(w http.ResponseWriter, r *http.Request, params SearchPlacesParams)
+	// List alert subscriptions of the current user
+	// (GET /users/me/alert-subscriptions)
+	ListAlertSubscriptions(w http.ResponseWriter, r *http.Request)
+	// Subscribe to an alert rule on a saved location
+	// (POST /users/me/alert-subscriptions)
+	AddAlertSubscription(w http.ResponseWriter, r *http.Request)
+	// Delete an alert subscription and its alerts
+	// (DELETE /users/me/alert-subscriptions/{id})
+	DeleteAlertSubscription(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get an alert subscription of the current user
+	// (GET /users/me/alert-subscriptions/{id})
+	GetAlertSubscription(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Change the rule of an alert subscription
+	// (PATCH /users/me/alert-subscriptions/{id})
+	UpdateAlertSubscription(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List alerts triggered for the current user
+	// (GET /users/me/alerts)
+	ListAlerts(w http.ResponseWriter, r *http.Request, params ListAlertsParams)
+	// List saved locations of the current user in their order
+	// (GET /users/me/locations)
+	ListLocations(w http.ResponseWriter, r *http.Request)
+	// Save a location at the end of the list
+	// (POST /users/me/locations)
+	AddLocation(w http.ResponseWriter, r *http.Request)
+	// Change the order of saved locations
+	// (PUT /users/me/locations/order)
+	ReorderLocations(w http.ResponseWriter, r *http.Request)
+	// Delete a saved location
+	// (DELETE /users/me/locations/{id})
+	DeleteLocation(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get a saved location
+	// (GET /users/me/locations/{id})
+	GetLocation(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Change name or coordinates of a saved location
+	// (PATCH /users/me/locations/{id})
+	UpdateLocation(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get notification settings of the current user
+	// (GET /users/me/notification-settings)
+	GetNotificationSettings(w http.ResponseWriter, r *http.Request)
+	// Change notification settings of the current user
+	// (PATCH /users/me/notification-settings)
+	UpdateNotificationSettings(w http.ResponseWriter, r *http.Request)
+	// Confirm the email for notifications with the code sent to it
+	// (POST /users/me/notification-settings/email/verification)
+	VerifyNotificationEmail(w http.ResponseWriter, r *http.Request)
+	// Change password of the current user
+	// (POST /users/me/password)
+	ChangePassword(w http.ResponseWriter, r *http.Request)
+	// Get preferences of the current user
+	// (GET /users/me/preferences)
+	GetPreferences(w http.ResponseWriter, r *http.Request)
+	// Change preferences of the current user
+	// (PATCH /users/me/preferences)
+	UpdatePreferences(w http.ResponseWriter, r *http.Request)
+	// List active sessions of the current user
+	// (GET /users/me/sessions)
+	ListSessions(w http.ResponseWriter, r *http.Request)
+	// Revoke a session of the current user
+	// (DELETE /users/me/sessions/{id})
+	DeleteSession(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Current weather conditions at the location
+	// (GET /weather/current)
+	GetCurrentWeather(w http.ResponseWriter, r *http.Request, params GetCurrentWeatherParams)
+	// Daily forecast for the location
+	// (GET /weather/forecast/daily)
+	GetDailyForecast(w http.ResponseWriter, r *http.Request, params GetDailyForecastParams)
+	// Hourly forecast for the location
+	// (GET /weather/forecast/hourly)
+	GetHourlyForecast(w http.ResponseWriter, r *http.Request, params GetHourlyForecastParams)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetJWKS operation middleware
+func (siw *ServerInterfaceWrapper) GetJWKS(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetJWKS(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Login operation middleware
+func (siw *ServerInterfaceWrapper) Login(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Login(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Logout operation middleware
+func (siw *ServerInterfaceWrapper) Logout(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Logout(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// LogoutAll operation middleware
+func (siw *ServerInterfaceWrapper) LogoutAll(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LogoutAll(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Refresh operation middleware
+func (siw *ServerInterfaceWrapper) Refresh(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Refresh(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Register operation middleware
+func (siw *ServerInterfaceWrapper) Register(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Register(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReverseGeocode operation middleware
+func (siw *ServerInterfaceWrapper) ReverseGeocode(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReverseGeocodeParams
+
+	// ------------- Required query parameter "lat" -------------
+
+	if paramValue := r.URL.Query().Get("lat"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "lat"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "lat", r.URL.Query(), &params.Lat)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lat", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "lon" -------------
+
+	if paramValue := r.URL.Query().Get("lon"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "lon"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "lon", r.URL.Query(), &params.Lon)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lon", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReverseGeocode(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SearchPlaces operation middleware
+func (siw *ServerInterfaceWrapper) SearchPlaces(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchPlacesParams
+
+	// ------------- Required query parameter "q" -------------
+
+	if paramValue := r.URL.Query().Get("q"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SearchPlaces(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListAlertSubscriptions operation middleware
+func (siw *ServerInterfaceWrapper) ListAlertSubscriptions(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAlertSubscriptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddAlertSubscription operation middleware
+func (siw *ServerInterfaceWrapper) AddAlertSubscription(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddAlertSubscription(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAlertSubscription operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAlertSubscription(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAlertSubscription(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAlertSubscription operation middleware
+func (siw *ServerInterfaceWrapper) GetAlertSubscription(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAlertSubscription(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateAlertSubscription operation middleware
+func (siw *ServerInterfaceWrapper) UpdateAlertSubscription(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateAlertSubscription(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListAlerts operation middleware
+func (siw *ServerInterfaceWrapper) ListAlerts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAlertsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAlerts(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListLocations operation middleware
+func (siw *ServerInterfaceWrapper) ListLocations(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListLocations(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddLocation operation middleware
+func (siw *ServerInterfaceWrapper) AddLocation(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddLocation(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReorderLocations operation middleware
+func (siw *ServerInterfaceWrapper) ReorderLocations(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReorderLocations(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteLocation operation middleware
+func (siw *ServerInterfaceWrapper) DeleteLocation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteLocation(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetLocation operation middleware
+func (siw *ServerInterfaceWrapper) GetLocation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLocation(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateLocation operation middleware
+func (siw *ServerInterfaceWrapper) UpdateLocation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateLocation(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetNotificationSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetNotificationSettings(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetNotificationSettings(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateNotificationSettings operation middleware
+func (siw *ServerInterfaceWrapper) UpdateNotificationSettings(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateNotificationSettings(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// VerifyNotificationEmail operation middleware
+func (siw *ServerInterfaceWrapper) VerifyNotificationEmail(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerifyNotificationEmail(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ChangePassword operation middleware
+func (siw *ServerInterfaceWrapper) ChangePassword(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ChangePassword(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPreferences operation middleware
+func (siw *ServerInterfaceWrapper) GetPreferences(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPreferences(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdatePreferences operation middleware
+func (siw *ServerInterfaceWrapper) UpdatePreferences(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdatePreferences(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListSessions operation middleware
+func (siw *ServerInterfaceWrapper) ListSessions(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSessions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteSession operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSession(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteSession(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCurrentWeather operation middleware
+func (siw *ServerInterfaceWrapper) GetCurrentWeather(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCurrentWeatherParams
+
+	// ------------- Optional query parameter "lat" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lat", r.URL.Query(), &params.Lat)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lat", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "lon" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lon", r.URL.Query(), &params.Lon)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lon", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "location" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "location", r.URL.Query(), &params.Location)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "location", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "place" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "place", r.URL.Query(), &params.Place)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "place", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "units" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "units", r.URL.Query(), &params.Units)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "units", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "windSpeedUnit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "windSpeedUnit", r.URL.Query(), &params.WindSpeedUnit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "windSpeedUnit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCurrentWeather(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetDailyForecast operation middleware
+func (siw *ServerInterfaceWrapper) GetDailyForecast(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDailyForecastParams
+
+	// ------------- Optional query parameter "lat" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lat", r.URL.Query(), &params.Lat)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lat", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "lon" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lon", r.URL.Query(), &params.Lon)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lon", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "location" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "location", r.URL.Query(), &params.Location)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "location", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "place" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "place", r.URL.Query(), &params.Place)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "place", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "units" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "units", r.URL.Query(), &params.Units)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "units", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "windSpeedUnit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "windSpeedUnit", r.URL.Query(), &params.WindSpeedUnit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "windSpeedUnit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "periods" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "periods", r.URL.Query(), &params.Periods)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "periods", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", r.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fields", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDailyForecast(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHourlyForecast operation middleware
+func (siw *ServerInterfaceWrapper) GetHourlyForecast(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetHourlyForecastParams
+
+	// ------------- Optional query parameter "lat" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lat", r.URL.Query(), &params.Lat)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lat", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "lon" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lon", r.URL.Query(), &params.Lon)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lon", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "location" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "location", r.URL.Query(), &params.Location)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "location", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "place" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "place", r.URL.Query(), &params.Place)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "place", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "units" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "units", r.URL.Query(), &params.Units)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "units", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "windSpeedUnit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "windSpeedUnit", r.URL.Query(), &params.WindSpeedUnit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "windSpeedUnit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "periods" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "periods", r.URL.Query(), &params.Periods)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "periods", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", r.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fields", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHourlyForecast(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("GET "+options.BaseURL+"/.well-known/jwks.json", wrapper.GetJWKS)
+	m.HandleFunc("POST "+options.BaseURL+"/auth/login", wrapper.Login)
+	m.HandleFunc("POST "+options.BaseURL+"/auth/logout", wrapper.Logout)
+	m.HandleFunc("POST "+options.BaseURL+"/auth/logout-all", wrapper.LogoutAll)
+	m.HandleFunc("POST "+options.BaseURL+"/auth/refresh", wrapper.Refresh)
+	m.HandleFunc("POST "+options.BaseURL+"/auth/register", wrapper.Register)
+	m.HandleFunc("GET "+options.BaseURL+"/geo/reverse", wrapper.ReverseGeocode)
+	m.HandleFunc("GET "+options.BaseURL+"/geo/search", wrapper.SearchPlaces)
+	m.HandleFunc("GET "+options.BaseURL+"/users/me/alert-subscriptions", wrapper.ListAlertSubscriptions)
+	m.HandleFunc("POST "+options.BaseURL+"/users/me/alert-subscriptions", wrapper.AddAlertSubscription)
+	m.HandleFunc("DELETE "+options.BaseURL+"/users/me/alert-subscriptions/{id}", wrapper.DeleteAlertSubscription)
+	m.HandleFunc("GET "+options.BaseURL+"/users/me/alert-subscriptions/{id}", wrapper.GetAlertSubscription)
+	m.HandleFunc("PATCH "+options.BaseURL+"/users/me/alert-subscriptions/{id}", wrapper.UpdateAlertSubscription)
+	m.HandleFunc("GET "+options.BaseURL+"/users/me/alerts", wrapper.ListAlerts)
+	m.HandleFunc("GET "+options.BaseURL+"/users/me/locations", wrapper.ListLocations)
+	m.HandleFunc("POST "+options.BaseURL+"/users/me/locations", wrapper.AddLocation)
+	m.HandleFunc("PUT "+options.BaseURL+"/users/me/locations/order", wrapper.ReorderLocations)
+	m.HandleFunc("DELETE "+options.BaseURL+"/users/me/locations/{id}", wrapper.DeleteLocation)
+	m.HandleFunc("GET "+options.BaseURL+"/users/me/locations/{id}", wrapper.GetLocation)
+	m.HandleFunc("PATCH "+options.BaseURL+"/users/me/locations/{id}", wrapper.UpdateLocation)
+	m.HandleFunc("GET "+options.BaseURL+"/users/me/notification-settings", wrapper.GetNotificationSettings)
+	m.HandleFunc("PATCH "+options.BaseURL+"/users/me/notification-settings", wrapper.UpdateNotificationSettings)
+	m.HandleFunc("POST "+options.BaseURL+"/users/me/notification-settings/email/verification", wrapper.VerifyNotificationEmail)
+	m.HandleFunc("POST "+options.BaseURL+"/users/me/password", wrapper.ChangePassword)
+	m.HandleFunc("GET "+options.BaseURL+"/users/me/preferences", wrapper.GetPreferences)
+	m.HandleFunc("PATCH "+options.BaseURL+"/users/me/preferences", wrapper.UpdatePreferences)
+	m.HandleFunc("GET "+options.BaseURL+"/users/me/sessions", wrapper.ListSessions)
+	m.HandleFunc("DELETE "+options.BaseURL+"/users/me/sessions/{id}", wrapper.DeleteSession)
+	m.HandleFunc("GET "+options.BaseURL+"/weather/current", wrapper.GetCurrentWeather)
+	m.HandleFunc("GET "+options.BaseURL+"/weather/forecast/daily", wrapper.GetDailyForecast)
+	m.HandleFunc("GET "+options.BaseURL+"/weather/forecast/hourly", wrapper.GetHourlyForecast)
+
+	return m
+}
+
+type GetJWKSRequestObject struct {
+}
+
+type GetJWKSResponseObject interface {
+	VisitGetJWKSResponse(w http.ResponseWriter) error
+}
+
+type GetJWKS200JSONResponse JWKSet
+
+func (response GetJWKS200JSONResponse) VisitGetJWKSResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LoginRequestObject struct {
+	Body *LoginJSONRequestBody
+}
+
+type LoginResponseObject interface {
+	VisitLoginResponse(w http.ResponseWriter) error
+}
+
+type Login200JSONResponse TokenPair
+
+func (response Login200JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Login401JSONResponse Error
+
+func (response Login401JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Login429ResponseHeaders struct {
+	RetryAfter int
+}
+
+type Login429JSONResponse struct {
+	Body    Error
+	Headers Login429ResponseHeaders
+}
+
+func (response Login429JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type Login500JSONResponse Error
+
+func (response Login500JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LogoutRequestObject struct {
+	Body *LogoutJSONRequestBody
+}
+
+type LogoutResponseObject interface {
+	VisitLogoutResponse(w http.ResponseWriter) error
+}
+
+type Logout204Response struct {
+}
+
+func (response Logout204Response) VisitLogoutResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type Logout401JSONResponse Error
+
+func (response Logout401JSONResponse) VisitLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Logout500JSONResponse Error
+
+func (response Logout500JSONResponse) VisitLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LogoutAllRequestObject struct {
+	Body *LogoutAllJSONRequestBody
+}
+
+type LogoutAllResponseObject interface {
+	VisitLogoutAllResponse(w http.ResponseWriter) error
+}
+
+type LogoutAll204Response struct {
+}
+
+func (response LogoutAll204Response) VisitLogoutAllResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type LogoutAll401JSONResponse Error
+
+func (response LogoutAll401JSONResponse) VisitLogoutAllResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LogoutAll500JSONResponse Error
+
+func (response LogoutAll500JSONResponse) VisitLogoutAllResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshRequestObject struct {
+	Body *RefreshJSONRequestBody
+}
+
+type RefreshResponseObject interface {
+	VisitRefreshResponse(w http.ResponseWriter) error
+}
+
+type Refresh200JSONResponse TokenPair
+
+func (response Refresh200JSONResponse) VisitRefreshResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Refresh401JSONResponse Error
+
+func (response Refresh401JSONResponse) VisitRefreshResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Refresh500JSONResponse Error
+
+func (response Refresh500JSONResponse) VisitRefreshResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterRequestObject struct {
+	Body *RegisterJSONRequestBody
+}
+
+type RegisterResponseObject interface {
+	VisitRegisterResponse(w http.ResponseWriter) error
+}
+
+type Register200JSONResponse TokenPair
+
+func (response Register200JSONResponse) VisitRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Register400JSONResponse Error
+
+func (response Register400JSONResponse) VisitRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Register409JSONResponse Error
+
+func (response Register409JSONResponse) VisitRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Register500JSONResponse Error
+
+func (response Register500JSONResponse) VisitRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReverseGeocodeRequestObject struct {
+	Params ReverseGeocodeParams
+}
+
+type ReverseGeocodeResponseObject interface {
+	VisitReverseGeocodeResponse(w http.ResponseWriter) error
+}
+
+type ReverseGeocode200JSONResponse Place
+
+func (response ReverseGeocode200JSONResponse) VisitReverseGeocodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReverseGeocode400JSONResponse Error
+
+func (response ReverseGeocode400JSONResponse) VisitReverseGeocodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReverseGeocode401JSONResponse Error
+
+func (response ReverseGeocode401JSONResponse) VisitReverseGeocodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReverseGeocode404JSONResponse Error
+
+func (response ReverseGeocode404JSONResponse) VisitReverseGeocodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReverseGeocode500JSONResponse Error
+
+func (response ReverseGeocode500JSONResponse) VisitReverseGeocodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReverseGeocode502JSONResponse Error
+
+func (response ReverseGeocode502JSONResponse) VisitReverseGeocodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(502)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchPlacesRequestObject struct {
+	Params SearchPlacesParams
+}
+
+type SearchPlacesResponseObject interface {
+	VisitSearchPlacesResponse(w http.ResponseWriter) error
+}
+
+type SearchPlaces200JSONResponse []Place
+
+func (response SearchPlaces200JSONResponse) VisitSearchPlacesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchPlaces400JSONResponse Error
+
+func (response SearchPlaces400JSONResponse) VisitSearchPlacesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchPlaces401JSONResponse Error
+
+func (response SearchPlaces401JSONResponse) VisitSearchPlacesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchPlaces500JSONResponse Error
+
+func (response SearchPlaces500JSONResponse) VisitSearchPlacesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchPlaces502JSONResponse Error
+
+func (response SearchPlaces502JSONResponse) VisitSearchPlacesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(502)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAlertSubscriptionsRequestObject struct {
+}
+
+type ListAlertSubscriptionsResponseObject interface {
+	VisitListAlertSubscriptionsResponse(w http.ResponseWriter) error
+}
+
+type ListAlertSubscriptions200JSONResponse []AlertSubscription
+
+func (response ListAlertSubscriptions200JSONResponse) VisitListAlertSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAlertSubscriptions401JSONResponse Error
+
+func (response ListAlertSubscriptions401JSONResponse) VisitListAlertSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAlertSubscriptions500JSONResponse Error
+
+func (response ListAlertSubscriptions500JSONResponse) VisitListAlertSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddAlertSubscriptionRequestObject struct {
+	Body *AddAlertSubscriptionJSONRequestBody
+}
+
+type AddAlertSubscriptionResponseObject interface {
+	VisitAddAlertSubscriptionResponse(w http.ResponseWriter) error
+}
+
+type AddAlertSubscription201JSONResponse AlertSubscription
+
+func (response AddAlertSubscription201JSONResponse) VisitAddAlertSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddAlertSubscription400JSONResponse Error
+
+func (response AddAlertSubscription400JSONResponse) VisitAddAlertSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddAlertSubscription401JSONResponse Error
+
+func (response AddAlertSubscription401JSONResponse) VisitAddAlertSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddAlertSubscription404JSONResponse Error
+
+func (response AddAlertSubscription404JSONResponse) VisitAddAlertSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddAlertSubscription409JSONResponse Error
+
+func (response AddAlertSubscription409JSONResponse) VisitAddAlertSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddAlertSubscription500JSONResponse Error
+
+func (response AddAlertSubscription500JSONResponse) VisitAddAlertSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAlertSubscriptionRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteAlertSubscriptionResponseObject interface {
+	VisitDeleteAlertSubscriptionResponse(w http.ResponseWriter) error
+}
+
+type DeleteAlertSubscription204Response struct {
+}
+
+func (response DeleteAlertSubscription204Response) VisitDeleteAlertSubscriptionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteAlertSubscription401JSONResponse Error
+
+func (response DeleteAlertSubscription401JSONResponse) VisitDeleteAlertSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAlertSubscription404JSONResponse Error
+
+func (response DeleteAlertSubscription404JSONResponse) VisitDeleteAlertSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAlertSubscription500JSONResponse Error
+
+func (response DeleteAlertSubscription500JSONResponse) VisitDeleteAlertSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAlertSubscriptionRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetAlertSubscriptionResponseObject interface {
+	VisitGetAlertSubscriptionResponse(w http.ResponseWriter) error
+}
+
+type GetAlertSubscription200JSONResponse AlertSubscription
+
+func (response GetAlertSubscription200JSONResponse) VisitGetAlertSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAlertSubscription401JSONResponse Error
+
+func (response GetAlertSubscription401JSONResponse) VisitGetAlertSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAlertSubscription404JSONResponse Error
+
+func (response GetAlertSubscription404JSONResponse) VisitGetAlertSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAlertSubscription500JSONResponse Error
+
+func (response GetAlertSubscription500JSONResponse) VisitGetAlertSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAlertSubscriptionRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdateAlertSubscriptionJSONRequestBody
+}
+
+type UpdateAlertSubscriptionResponseObject interface {
+	VisitUpdateAlertSubscriptionResponse(w http.ResponseWriter) error
+}
+
+type UpdateAlertSubscription200JSONResponse AlertSubscription
+
+func (response UpdateAlertSubscription200JSONResponse) VisitUpdateAlertSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAlertSubscription400JSONResponse Error
+
+func (response UpdateAlertSubscription400JSONResponse) VisitUpdateAlertSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAlertSubscription401JSONResponse Error
+
+func (response UpdateAlertSubscription401JSONResponse) VisitUpdateAlertSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAlertSubscription404JSONResponse Error
+
+func (response UpdateAlertSubscription404JSONResponse) VisitUpdateAlertSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAlertSubscription500JSONResponse Error
+
+func (response UpdateAlertSubscription500JSONResponse) VisitUpdateAlertSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAlertsRequestObject struct {
+	Params ListAlertsParams
+}
+
+type ListAlertsResponseObject interface {
+	VisitListAlertsResponse(w http.ResponseWriter) error
+}
+
+type ListAlerts200JSONResponse []Alert
+
+func (response ListAlerts200JSONResponse) VisitListAlertsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAlerts400JSONResponse Error
+
+func (response ListAlerts400JSONResponse) VisitListAlertsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAlerts401JSONResponse Error
+
+func (response ListAlerts401JSONResponse) VisitListAlertsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAlerts500JSONResponse Error
+
+func (response ListAlerts500JSONResponse) VisitListAlertsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListLocationsRequestObject struct {
+}
+
+type ListLocationsResponseObject interface {
+	VisitListLocationsResponse(w http.ResponseWriter) error
+}
+
+type ListLocations200JSONResponse []Location
+
+func (response ListLocations200JSONResponse) VisitListLocationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListLocations401JSONResponse Error
+
+func (response ListLocations401JSONResponse) VisitListLocationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListLocations500JSONResponse Error
+
+func (response ListLocations500JSONResponse) VisitListLocationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddLocationRequestObject struct {
+	Body *AddLocationJSONRequestBody
+}
+
+type AddLocationResponseObject interface {
+	VisitAddLocationResponse(w http.ResponseWriter) error
+}
+
+type AddLocation201JSONResponse Location
+
+func (response AddLocation201JSONResponse) VisitAddLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddLocation400JSONResponse Error
+
+func (response AddLocation400JSONResponse) VisitAddLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddLocation401JSONResponse Error
+
+func (response AddLocation401JSONResponse) VisitAddLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddLocation404JSONResponse Error
+
+func (response AddLocation404JSONResponse) VisitAddLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddLocation409JSONResponse Error
+
+func (response AddLocation409JSONResponse) VisitAddLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddLocation500JSONResponse Error
+
+func (response AddLocation500JSONResponse) VisitAddLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReorderLocationsRequestObject struct {
+	Body *ReorderLocationsJSONRequestBody
+}
+
+type ReorderLocationsResponseObject interface {
+	VisitReorderLocationsResponse(w http.ResponseWriter) error
+}
+
+type ReorderLocations200JSONResponse []Location
+
+func (response ReorderLocations200JSONResponse) VisitReorderLocationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReorderLocations400JSONResponse Error
+
+func (response ReorderLocations400JSONResponse) VisitReorderLocationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReorderLocations401JSONResponse Error
+
+func (response ReorderLocations401JSONResponse) VisitReorderLocationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReorderLocations500JSONResponse Error
+
+func (response ReorderLocations500JSONResponse) VisitReorderLocationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteLocationRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteLocationResponseObject interface {
+	VisitDeleteLocationResponse(w http.ResponseWriter) error
+}
+
+type DeleteLocation204Response struct {
+}
+
+func (response DeleteLocation204Response) VisitDeleteLocationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteLocation401JSONResponse Error
+
+func (response DeleteLocation401JSONResponse) VisitDeleteLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteLocation404JSONResponse Error
+
+func (response DeleteLocation404JSONResponse) VisitDeleteLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteLocation500JSONResponse Error
+
+func (response DeleteLocation500JSONResponse) VisitDeleteLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLocationRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetLocationResponseObject interface {
+	VisitGetLocationResponse(w http.ResponseWriter) error
+}
+
+type GetLocation200JSONResponse Location
+
+func (response GetLocation200JSONResponse) VisitGetLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLocation401JSONResponse Error
+
+func (response GetLocation401JSONResponse) VisitGetLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLocation404JSONResponse Error
+
+func (response GetLocation404JSONResponse) VisitGetLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLocation500JSONResponse Error
+
+func (response GetLocation500JSONResponse) VisitGetLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateLocationRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdateLocationJSONRequestBody
+}
+
+type UpdateLocationResponseObject interface {
+	VisitUpdateLocationResponse(w http.ResponseWriter) error
+}
+
+type UpdateLocation200JSONResponse Location
+
+func (response UpdateLocation200JSONResponse) VisitUpdateLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateLocation400JSONResponse Error
+
+func (response UpdateLocation400JSONResponse) VisitUpdateLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateLocation401JSONResponse Error
+
+func (response UpdateLocation401JSONResponse) VisitUpdateLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateLocation404JSONResponse Error
+
+func (response UpdateLocation404JSONResponse) VisitUpdateLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateLocation409JSONResponse Error
+
+func (response UpdateLocation409JSONResponse) VisitUpdateLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateLocation500JSONResponse Error
+
+func (response UpdateLocation500JSONResponse) VisitUpdateLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNotificationSettingsRequestObject struct {
+}
+
+type GetNotificationSettingsResponseObject interface {
+	VisitGetNotificationSettingsResponse(w http.ResponseWriter) error
+}
+
+type GetNotificationSettings200JSONResponse NotificationSettings
+
+func (response GetNotificationSettings200JSONResponse) VisitGetNotificationSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNotificationSettings401JSONResponse Error
+
+func (response GetNotificationSettings401JSONResponse) VisitGetNotificationSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNotificationSettings500JSONResponse Error
+
+func (response GetNotificationSettings500JSONResponse) VisitGetNotificationSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateNotificationSettingsRequestObject struct {
+	Body *UpdateNotificationSettingsJSONRequestBody
+}
+
+type UpdateNotificationSettingsResponseObject interface {
+	VisitUpdateNotificationSettingsResponse(w http.ResponseWriter) error
+}
+
+type UpdateNotificationSettings200JSONResponse NotificationSettings
+
+func (response UpdateNotificationSettings200JSONResponse) VisitUpdateNotificationSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateNotificationSettings400JSONResponse Error
+
+func (response UpdateNotificationSettings400JSONResponse) VisitUpdateNotificationSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateNotificationSettings401JSONResponse Error
+
+func (response UpdateNotificationSettings401JSONResponse) VisitUpdateNotificationSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateNotificationSettings500JSONResponse Error
+
+func (response UpdateNotificationSettings500JSONResponse) VisitUpdateNotificationSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyNotificationEmailRequestObject struct {
+	Body *VerifyNotificationEmailJSONRequestBody
+}
+
+type VerifyNotificationEmailResponseObject interface {
+	VisitVerifyNotificationEmailResponse(w http.ResponseWriter) error
+}
+
+type VerifyNotificationEmail200JSONResponse NotificationSettings
+
+func (response VerifyNotificationEmail200JSONResponse) VisitVerifyNotificationEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyNotificationEmail400JSONResponse Error
+
+func (response VerifyNotificationEmail400JSONResponse) VisitVerifyNotificationEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyNotificationEmail401JSONResponse Error
+
+func (response VerifyNotificationEmail401JSONResponse) VisitVerifyNotificationEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyNotificationEmail500JSONResponse Error
+
+func (response VerifyNotificationEmail500JSONResponse) VisitVerifyNotificationEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangePasswordRequestObject struct {
+	Body *ChangePasswordJSONRequestBody
+}
+
+type ChangePasswordResponseObject interface {
+	VisitChangePasswordResponse(w http.ResponseWriter) error
+}
+
+type ChangePassword204Response struct {
+}
+
+func (response ChangePassword204Response) VisitChangePasswordResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ChangePassword400JSONResponse Error
+
+func (response ChangePassword400JSONResponse) VisitChangePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangePassword401JSONResponse Error
+
+func (response ChangePassword401JSONResponse) VisitChangePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangePassword429ResponseHeaders struct {
+	RetryAfter int
+}
+
+type ChangePassword429JSONResponse struct {
+	Body    Error
+	Headers ChangePassword429ResponseHeaders
+}
+
+func (response ChangePassword429JSONResponse) VisitChangePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ChangePassword500JSONResponse Error
+
+func (response ChangePassword500JSONResponse) VisitChangePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPreferencesRequestObject struct {
+}
+
+type GetPreferencesResponseObject interface {
+	VisitGetPreferencesResponse(w http.ResponseWriter) error
+}
+
+type GetPreferences200JSONResponse Preferences
+
+func (response GetPreferences200JSONResponse) VisitGetPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPreferences401JSONResponse Error
+
+func (response GetPreferences401JSONResponse) VisitGetPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPreferences500JSONResponse Error
+
+func (response GetPreferences500JSONResponse) VisitGetPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePreferencesRequestObject struct {
+	Body *UpdatePreferencesJSONRequestBody
+}
+
+type UpdatePreferencesResponseObject interface {
+	VisitUpdatePreferencesResponse(w http.ResponseWriter) error
+}
+
+type UpdatePreferences200JSONResponse Preferences
+
+func (response UpdatePreferences200JSONResponse) VisitUpdatePreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePreferences400JSONResponse Error
+
+func (response UpdatePreferences400JSONResponse) VisitUpdatePreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePreferences401JSONResponse Error
+
+func (response UpdatePreferences401JSONResponse) VisitUpdatePreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePreferences500JSONResponse Error
+
+func (response UpdatePreferences500JSONResponse) VisitUpdatePreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSessionsRequestObject struct {
+}
+
+type ListSessionsResponseObject interface {
+	VisitListSessionsResponse(w http.ResponseWriter) error
+}
+
+type ListSessions200JSONResponse []Session
+
+func (response ListSessions200JSONResponse) VisitListSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSessions401JSONResponse Error
+
+func (response ListSessions401JSONResponse) VisitListSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSessions500JSONResponse Error
+
+func (response ListSessions500JSONResponse) VisitListSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSessionRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteSessionResponseObject interface {
+	VisitDeleteSessionResponse(w http.ResponseWriter) error
+}
+
+type DeleteSession204Response struct {
+}
+
+func (response DeleteSession204Response) VisitDeleteSessionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteSession401JSONResponse Error
+
+func (response DeleteSession401JSONResponse) VisitDeleteSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSession404JSONResponse Error
+
+func (response DeleteSession404JSONResponse) VisitDeleteSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSession500JSONResponse Error
+
+func (response DeleteSession500JSONResponse) VisitDeleteSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCurrentWeatherRequestObject struct {
+	Params GetCurrentWeatherParams
+}
+
+type GetCurrentWeatherResponseObject interface {
+	VisitGetCurrentWeatherResponse(w http.ResponseWriter) error
+}
+
+type GetCurrentWeather200JSONResponse CurrentWeather
+
+func (response GetCurrentWeather200JSONResponse) VisitGetCurrentWeatherResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCurrentWeather400JSONResponse Error
+
+func (response GetCurrentWeather400JSONResponse) VisitGetCurrentWeatherResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCurrentWeather401JSONResponse Error
+
+func (response GetCurrentWeather401JSONResponse) VisitGetCurrentWeatherResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCurrentWeather404JSONResponse Error
+
+func (response GetCurrentWeather404JSONResponse) VisitGetCurrentWeatherResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCurrentWeather500JSONResponse Error
+
+func (response GetCurrentWeather500JSONResponse) VisitGetCurrentWeatherResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCurrentWeather502JSONResponse Error
+
+func (response GetCurrentWeather502JSONResponse) VisitGetCurrentWeatherResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(502)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDailyForecastRequestObject struct {
+	Params GetDailyForecastParams
+}
+
+type GetDailyForecastResponseObject interface {
+	VisitGetDailyForecastResponse(w http.ResponseWriter) error
+}
+
+type GetDailyForecast200JSONResponse DailyForecast
+
+func (response GetDailyForecast200JSONResponse) VisitGetDailyForecastResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDailyForecast400JSONResponse Error
+
+func (response GetDailyForecast400JSONResponse) VisitGetDailyForecastResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDailyForecast401JSONResponse Error
+
+func (response GetDailyForecast401JSONResponse) VisitGetDailyForecastResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDailyForecast404JSONResponse Error
+
+func (response GetDailyForecast404JSONResponse) VisitGetDailyForecastResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDailyForecast500JSONResponse Error
+
+func (response GetDailyForecast500JSONResponse) VisitGetDailyForecastResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDailyForecast502JSONResponse Error
+
+func (response GetDailyForecast502JSONResponse) VisitGetDailyForecastResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(502)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHourlyForecastRequestObject struct {
+	Params GetHourlyForecastParams
+}
+
+type GetHourlyForecastResponseObject interface {
+	VisitGetHourlyForecastResponse(w http.ResponseWriter) error
+}
+
+type GetHourlyForecast200JSONResponse HourlyForecast
+
+func (response GetHourlyForecast200JSONResponse) VisitGetHourlyForecastResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHourlyForecast400JSONResponse Error
+
+func (response GetHourlyForecast400JSONResponse) VisitGetHourlyForecastResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHourlyForecast401JSONResponse Error
+
+func (response GetHourlyForecast401JSONResponse) VisitGetHourlyForecastResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHourlyForecast404JSONResponse Error
+
+func (response GetHourlyForecast404JSONResponse) VisitGetHourlyForecastResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHourlyForecast500JSONResponse Error
+
+func (response GetHourlyForecast500JSONResponse) VisitGetHourlyForecastResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHourlyForecast502JSONResponse Error
+
+func (response GetHourlyForecast502JSONResponse) VisitGetHourlyForecastResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(502)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Public keys to validate access tokens
+	// (GET /.well-known/jwks.json)
+	GetJWKS(ctx context.Context, request GetJWKSRequestObject) (GetJWKSResponseObject, error)
+	// Authenticate an existing user
+	// (POST /auth/login)
+	Login(ctx context.Context, request LoginRequestObject) (LoginResponseObject, error)
+	// Revoke the session of the provided refresh token
+	// (POST /auth/logout)
+	Logout(ctx context.Context, request LogoutRequestObject) (LogoutResponseObject, error)
+	// Revoke every session of the user owning the refresh token
+	// (POST /auth/logout-all)
+	LogoutAll(ctx context.Context, request LogoutAllRequestObject) (LogoutAllResponseObject, error)
+	// Exchange a refresh token for a new token pair
+	// (POST /auth/refresh)
+	Refresh(ctx context.Context, request RefreshRequestObject) (RefreshResponseObject, error)
+	// Register a new user account
+	// (POST /auth/register)
+	Register(ctx context.Context, request RegisterRequestObject) (RegisterResponseObject, error)
+	// Find the place at the coordinates
+	// (GET /geo/reverse)
+	ReverseGeocode(ctx context.Context, request ReverseGeocodeRequestObject) (ReverseGeocodeResponseObject, error)
+	// Find places by name
+	// (GET /geo/search)
+	SearchPlaces(ctx context.Context, request SearchPlacesRequestObject) (SearchPlacesResponseObject, error)
+	// List alert subscriptions of the current user
+	// (GET /users/me/alert-subscriptions)
+	ListAlertSubscriptions(ctx context.Context, request ListAlertSubscriptionsRequestObject) (ListAlertSubscriptionsResponseObject, error)
+	// Subscribe to an alert rule on a saved location
+	// (POST /users/me/alert-subscriptions)
+	AddAlertSubscription(ctx context.Context, request AddAlertSubscriptionRequestObject) (AddAlertSubscriptionResponseObject, error)
+	// Delete an alert subscription and its alerts
+	// (DELETE /users/me/alert-subscriptions/{id})
+	DeleteAlertSubscription(ctx context.Context, request DeleteAlertSubscriptionRequestObject) (DeleteAlertSubscriptionResponseObject, error)
+	// Get an alert subscription of the current user
+	// (GET /users/me/alert-subscriptions/{id})
+	GetAlertSubscription(ctx context.Context, request GetAlertSubscriptionRequestObject) (GetAlertSubscriptionResponseObject, error)
+	// Change the rule of an alert subscription
+	// (PATCH /users/me/alert-subscriptions/{id})
+	UpdateAlertSubscription(ctx context.Context, request UpdateAlertSubscriptionRequestObject) (UpdateAlertSubscriptionResponseObject, error)
+	// List alerts triggered for the current user
+	// (GET /users/me/alerts)
+	ListAlerts(ctx context.Context, request ListAlertsRequestObject) (ListAlertsResponseObject, error)
+	// List saved locations of the current user in their order
+	// (GET /users/me/locations)
+	ListLocations(ctx context.Context, request ListLocationsRequestObject) (ListLocationsResponseObject, error)
+	// Save a location at the end of the list
+	// (POST /users/me/locations)
+	AddLocation(ctx context.Context, request AddLocationRequestObject) (AddLocationResponseObject, error)
+	// Change the order of saved locations
+	// (PUT /users/me/locations/order)
+	ReorderLocations(ctx context.Context, request ReorderLocationsRequestObject) (ReorderLocationsResponseObject, error)
+	// Delete a saved location
+	// (DELETE /users/me/locations/{id})
+	DeleteLocation(ctx context.Context, request DeleteLocationRequestObject) (DeleteLocationResponseObject, error)
+	// Get a saved location
+	// (GET /users/me/locations/{id})
+	GetLocation(ctx context.Context, request GetLocationRequestObject) (GetLocationResponseObject, error)
+	// Change name or coordinates of a saved location
+	// (PATCH /users/me/locations/{id})
+	UpdateLocation(ctx context.Context, request UpdateLocationRequestObject) (UpdateLocationResponseObject, error)
+	// Get notification settings of the current user
+	// (GET /users/me/notification-settings)
+	GetNotificationSettings(ctx context.Context, request GetNotificationSettingsRequestObject) (GetNotificationSettingsResponseObject, error)
+	// Change notification settings of the current user
+	// (PATCH /users/me/notification-settings)
+	UpdateNotificationSettings(ctx context.Context, request UpdateNotificationSettingsRequestObject) (UpdateNotificationSettingsResponseObject, error)
+	// Confirm the email for notifications with the code sent to it
+	// (POST /users/me/notification-settings/email/verification)
+	VerifyNotificationEmail(ctx context.Context, request VerifyNotificationEmailRequestObject) (VerifyNotificationEmailResponseObject, error)
+	// Change password of the current user
+	// (POST /users/me/password)
+	ChangePassword(ctx context.Context, request ChangePasswordRequestObject) (ChangePasswordResponseObject, error)
+	// Get preferences of the current user
+	// (GET /users/me/preferences)
+	GetPreferences(ctx context.Context, request GetPreferencesRequestObject) (GetPreferencesResponseObject, error)
+	// Change preferences of the current user
+	// (PATCH /users/me/preferences)
+	UpdatePreferences(ctx context.Context, request UpdatePreferencesRequestObject) (UpdatePreferencesResponseObject, error)
+	// List active sessions of the current user
+	// (GET /users/me/sessions)
+	ListSessions(ctx context.Context, request ListSessionsRequestObject) (ListSessionsResponseObject, error)
+	// Revoke a session of the current user
+	// (DELETE /users/me/sessions/{id})
+	DeleteSession(ctx context.Context, request DeleteSessionRequestObject) (DeleteSessionResponseObject, error)
+	// Current weather conditions at the location
+	// (GET /weather/current)
+	GetCurrentWeather(ctx context.Context, request GetCurrentWeatherRequestObject) (GetCurrentWeatherResponseObject, error)
+	// Daily forecast for the location
+	// (GET /weather/forecast/daily)
+	GetDailyForecast(ctx context.Context, request GetDailyForecastRequestObject) (GetDailyForecastResponseObject, error)
+	// Hourly forecast for the location
+	// (GET /weather/forecast/hourly)
+	GetHourlyForecast(ctx context.Context, request GetHourlyForecastRequestObject) (GetHourlyForecastResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetJWKS operation middleware
+func (sh *strictHandler) GetJWKS(w http.ResponseWriter, r *http.Request) {
+	var request GetJWKSRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetJWKS(ctx, request.(GetJWKSRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetJWKS")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetJWKSResponseObject); ok {
+		if err := validResponse.VisitGetJWKSResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Login operation middleware
+func (sh *strictHandler) Login(w http.ResponseWriter, r *http.Request) {
+	var request LoginRequestObject
+
+	var body LoginJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Login(ctx, request.(LoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Login")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(LoginResponseObject); ok {
+		if err := validResponse.VisitLoginResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Logout operation middleware
+func (sh *strictHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	var request LogoutRequestObject
+
+	var body LogoutJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Logout(ctx, request.(LogoutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Logout")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(LogoutResponseObject); ok {
+		if err := validResponse.VisitLogoutResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// LogoutAll operation middleware
+func (sh *strictHandler) LogoutAll(w http.ResponseWriter, r *http.Request) {
+	var request LogoutAllRequestObject
+
+	var body LogoutAllJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.LogoutAll(ctx, request.(LogoutAllRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LogoutAll")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(LogoutAllResponseObject); ok {
+		if err := validResponse.VisitLogoutAllResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Refresh operation middleware
+func (sh *strictHandler) Refresh(w http.ResponseWriter, r *http.Request) {
+	var request RefreshRequestObject
+
+	var body RefreshJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Refresh(ctx, request.(RefreshRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Refresh")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RefreshResponseObject); ok {
+		if err := validResponse.VisitRefreshResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
 // Register operation middleware
 func (sh *strictHandler) Register(w http.ResponseWriter, r *http.Request) {
 	var request RegisterRequestObject
 
-	var body RegisterJSONRequestBody
+	var body RegisterJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Register(ctx, request.(RegisterRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Register")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RegisterResponseObject); ok {
+		if err := validResponse.VisitRegisterResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReverseGeocode operation middleware
+func (sh *strictHandler) ReverseGeocode(w http.ResponseWriter, r *http.Request, params ReverseGeocodeParams) {
+	var request ReverseGeocodeRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReverseGeocode(ctx, request.(ReverseGeocodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReverseGeocode")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReverseGeocodeResponseObject); ok {
+		if err := validResponse.VisitReverseGeocodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SearchPlaces operation middleware
+func (sh *strictHandler) SearchPlaces(w http.ResponseWriter, r *http.Request, params SearchPlacesParams) {
+	var request SearchPlacesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SearchPlaces(ctx, request.(SearchPlacesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SearchPlaces")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SearchPlacesResponseObject); ok {
+		if err := validResponse.VisitSearchPlacesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListAlertSubscriptions operation middleware
+func (sh *strictHandler) ListAlertSubscriptions(w http.ResponseWriter, r *http.Request) {
+	var request ListAlertSubscriptionsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAlertSubscriptions(ctx, request.(ListAlertSubscriptionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAlertSubscriptions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAlertSubscriptionsResponseObject); ok {
+		if err := validResponse.VisitListAlertSubscriptionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AddAlertSubscription operation middleware
+func (sh *strictHandler) AddAlertSubscription(w http.ResponseWriter, r *http.Request) {
+	var request AddAlertSubscriptionRequestObject
+
+	var body AddAlertSubscriptionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AddAlertSubscription(ctx, request.(AddAlertSubscriptionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddAlertSubscription")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AddAlertSubscriptionResponseObject); ok {
+		if err := validResponse.VisitAddAlertSubscriptionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAlertSubscription operation middleware
+func (sh *strictHandler) DeleteAlertSubscription(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteAlertSubscriptionRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAlertSubscription(ctx, request.(DeleteAlertSubscriptionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAlertSubscription")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteAlertSubscriptionResponseObject); ok {
+		if err := validResponse.VisitDeleteAlertSubscriptionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAlertSubscription operation middleware
+func (sh *strictHandler) GetAlertSubscription(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetAlertSubscriptionRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAlertSubscription(ctx, request.(GetAlertSubscriptionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAlertSubscription")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAlertSubscriptionResponseObject); ok {
+		if err := validResponse.VisitGetAlertSubscriptionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateAlertSubscription operation middleware
+func (sh *strictHandler) UpdateAlertSubscription(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request UpdateAlertSubscriptionRequestObject
+
+	request.Id = id
+
+	var body UpdateAlertSubscriptionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateAlertSubscription(ctx, request.(UpdateAlertSubscriptionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateAlertSubscription")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateAlertSubscriptionResponseObject); ok {
+		if err := validResponse.VisitUpdateAlertSubscriptionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListAlerts operation middleware
+func (sh *strictHandler) ListAlerts(w http.ResponseWriter, r *http.Request, params ListAlertsParams) {
+	var request ListAlertsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAlerts(ctx, request.(ListAlertsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAlerts")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAlertsResponseObject); ok {
+		if err := validResponse.VisitListAlertsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListLocations operation middleware
+func (sh *strictHandler) ListLocations(w http.ResponseWriter, r *http.Request) {
+	var request ListLocationsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListLocations(ctx, request.(ListLocationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListLocations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListLocationsResponseObject); ok {
+		if err := validResponse.VisitListLocationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AddLocation operation middleware
+func (sh *strictHandler) AddLocation(w http.ResponseWriter, r *http.Request) {
+	var request AddLocationRequestObject
+
+	var body AddLocationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AddLocation(ctx, request.(AddLocationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddLocation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AddLocationResponseObject); ok {
+		if err := validResponse.VisitAddLocationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReorderLocations operation middleware
+func (sh *strictHandler) ReorderLocations(w http.ResponseWriter, r *http.Request) {
+	var request ReorderLocationsRequestObject
+
+	var body ReorderLocationsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReorderLocations(ctx, request.(ReorderLocationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReorderLocations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReorderLocationsResponseObject); ok {
+		if err := validResponse.VisitReorderLocationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteLocation operation middleware
+func (sh *strictHandler) DeleteLocation(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteLocationRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteLocation(ctx, request.(DeleteLocationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteLocation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteLocationResponseObject); ok {
+		if err := validResponse.VisitDeleteLocationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetLocation operation middleware
+func (sh *strictHandler) GetLocation(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetLocationRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLocation(ctx, request.(GetLocationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLocation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLocationResponseObject); ok {
+		if err := validResponse.VisitGetLocationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateLocation operation middleware
+func (sh *strictHandler) UpdateLocation(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request UpdateLocationRequestObject
+
+	request.Id = id
+
+	var body UpdateLocationJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -293,18 +3997,318 @@ func (sh *strictHandler) Register(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.Register(ctx, request.(RegisterRequestObject))
+		return sh.ssi.UpdateLocation(ctx, request.(UpdateLocationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Register")
+		handler = middleware(handler, "UpdateLocation")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RegisterResponseObject); ok {
-		if err := validResponse.VisitRegisterResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateLocationResponseObject); ok {
+		if err := validResponse.VisitUpdateLocationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetNotificationSettings operation middleware
+func (sh *strictHandler) GetNotificationSettings(w http.ResponseWriter, r *http.Request) {
+	var request GetNotificationSettingsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetNotificationSettings(ctx, request.(GetNotificationSettingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetNotificationSettings")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetNotificationSettingsResponseObject); ok {
+		if err := validResponse.VisitGetNotificationSettingsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateNotificationSettings operation middleware
+func (sh *strictHandler) UpdateNotificationSettings(w http.ResponseWriter, r *http.Request) {
+	var request UpdateNotificationSettingsRequestObject
+
+	var body UpdateNotificationSettingsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateNotificationSettings(ctx, request.(UpdateNotificationSettingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateNotificationSettings")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateNotificationSettingsResponseObject); ok {
+		if err := validResponse.VisitUpdateNotificationSettingsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// VerifyNotificationEmail operation middleware
+func (sh *strictHandler) VerifyNotificationEmail(w http.ResponseWriter, r *http.Request) {
+	var request VerifyNotificationEmailRequestObject
+
+	var body VerifyNotificationEmailJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.VerifyNotificationEmail(ctx, request.(VerifyNotificationEmailRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "VerifyNotificationEmail")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(VerifyNotificationEmailResponseObject); ok {
+		if err := validResponse.VisitVerifyNotificationEmailResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ChangePassword operation middleware
+func (sh *strictHandler) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	var request ChangePasswordRequestObject
+
+	var body ChangePasswordJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ChangePassword(ctx, request.(ChangePasswordRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ChangePassword")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ChangePasswordResponseObject); ok {
+		if err := validResponse.VisitChangePasswordResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPreferences operation middleware
+func (sh *strictHandler) GetPreferences(w http.ResponseWriter, r *http.Request) {
+	var request GetPreferencesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPreferences(ctx, request.(GetPreferencesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPreferences")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPreferencesResponseObject); ok {
+		if err := validResponse.VisitGetPreferencesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdatePreferences operation middleware
+func (sh *strictHandler) UpdatePreferences(w http.ResponseWriter, r *http.Request) {
+	var request UpdatePreferencesRequestObject
+
+	var body UpdatePreferencesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdatePreferences(ctx, request.(UpdatePreferencesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdatePreferences")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdatePreferencesResponseObject); ok {
+		if err := validResponse.VisitUpdatePreferencesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListSessions operation middleware
+func (sh *strictHandler) ListSessions(w http.ResponseWriter, r *http.Request) {
+	var request ListSessionsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListSessions(ctx, request.(ListSessionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListSessions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListSessionsResponseObject); ok {
+		if err := validResponse.VisitListSessionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteSession operation middleware
+func (sh *strictHandler) DeleteSession(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteSessionRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteSession(ctx, request.(DeleteSessionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteSession")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteSessionResponseObject); ok {
+		if err := validResponse.VisitDeleteSessionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCurrentWeather operation middleware
+func (sh *strictHandler) GetCurrentWeather(w http.ResponseWriter, r *http.Request, params GetCurrentWeatherParams) {
+	var request GetCurrentWeatherRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCurrentWeather(ctx, request.(GetCurrentWeatherRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCurrentWeather")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCurrentWeatherResponseObject); ok {
+		if err := validResponse.VisitGetCurrentWeatherResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetDailyForecast operation middleware
+func (sh *strictHandler) GetDailyForecast(w http.ResponseWriter, r *http.Request, params GetDailyForecastParams) {
+	var request GetDailyForecastRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDailyForecast(ctx, request.(GetDailyForecastRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDailyForecast")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDailyForecastResponseObject); ok {
+		if err := validResponse.VisitGetDailyForecastResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetHourlyForecast operation middleware
+func (sh *strictHandler) GetHourlyForecast(w http.ResponseWriter, r *http.Request, params GetHourlyForecastParams) {
+	var request GetHourlyForecastRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHourlyForecast(ctx, request.(GetHourlyForecastRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHourlyForecast")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetHourlyForecastResponseObject); ok {
+		if err := validResponse.VisitGetHourlyForecastResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -315,17 +4319,103 @@ func (sh *strictHandler) Register(w http.ResponseWriter, r *http.Request) {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/8RUTW/UMBD9K9bAMTRbPqSSW0E9VEKiQiAO1R6GeHbj4tjGM9ntqsp/R7a73wtIqIhb",
-	"PJ6P917m+QFa3wfvyAlD8wDcdtRj/nwfSZMTgzYfQ/SBohjKJ+vnxqUPWQWCBliicXMYKwjIvPRRp8uZ",
-	"jz0KNNtgdVgwVhDpx2AiaWhuH/vudJluKvy3O2oljbiK0cdjTK3XdBKSJkFTWBz16okZ56frxPTEgn3Y",
-	"46JR6EW6+iOZDGi3zXbcKVqf/XdyN2hOUMO2JeacsIflbinHKBKIWSTuNvm/Tbi6DyYSX8pfstzFdjD6",
-	"V4OO2aemxs18wqCJ22iCGO+ggcuba+Vn6iuhdBQVhpAlFZvKH6OXObigyKXm/GxyNkk8fSCHwUADr3Io",
-	"LZZ0WdIaB+nqSHPDQkVyz1mDJDym6dcaGvi0ziisieWd16uyb07I5RIMwZo2F9V37N3WSunreaQZNPCs",
-	"3nqtfjRaveuycV9aiQPlAAfvuCzCy8nkyUZv9y0P3pe90C46KMOKh/ybZ4O1Z0nZ108IpPj5BIib6BdG",
-	"k1YaBdUSWRm3QGt0QfD23yP4whTV0kinwhpLfqIU2kioV4ruDQsnPG/+syJjBTz0PcbVztYqVI6Wakg0",
-	"sG394PKDgXPO1h2kS8tXMEIFKY9hmgczxeQoaG4PLfnBt2iVpgVZH3pyokpuahAtNNCJhKaubcrrPEtz",
-	"MbmY1ItzGKeb4Q+nlN4HpNBpxcTJ1apHh3NK06ACh32y/wH+sTps+nFtZVaRLAppJV4Vlpsu5XhcvH5y",
-	"stSRJBpaoN3WLcs9jNPxZwAAAP//wbQUYEcHAAA=",
+	"H4sIAAAAAAAC/+w97XLbOJKvguLdj7s6+iPeJDvrqvvh9SST7GYmrjjZXN04dQWJLQljEtACoGVNyu90",
+	"z3BPdoUGQIIkKFG2ZTsZ/XFZJAg0Gv3dDeBrMhbFXHDgWiXHX5M5lbQADRJ/vaOa6TID838GaizZXDPB",
+	"k+PkA/yzZBIysmB6RnLBU1LyHJQiuRhT04gISeY5HQNhisyluGIZZEmaMPP5P0uQyyRNOC0gOU5yqpM0",
+	"UeMZFNSMNRGyoDo5TjJRjnJI0qSg16woi+T4L4dpUjBuf+yZX3o5N33wshiBTG5u0uSdA+Ft1gX8bUbE",
+	"hFCi6BVkNbBakFIBYVxpoNgkp5pQbprwPqjdx3HQy5JlSQWd0pLxqYOOT4dhleq7YVXwoVh99kMDrfiz",
+	"i9czM/AqpFrIJlIUZAqCKKByPLsVbrGnBvRdRH7iTKsuMOYxUUuloYgMrWdABHdAmh9zCROQwMegemAp",
+	"cZwQln+VMEmOk385qJnnwL5VB2b4cxwdgfzMeHY+B8jM8x5gxYQsGM+IMu3U/QC9aIw7FPgmtDdmAu6V",
+	"+fIkB4lzmEsxB6kZWPTTZXdiP9KlgXwiJIyp0h9ZYWaEwGvz/+9mPm5qpQKZ4n+qHFWdEC3ZdApSEcHH",
+	"Zv4EJ1JTMdXQZbA0CYdskj3VsGcGj33FsgHcm1Y8/3ZY8wK0ZON1WEe8/myb3qSJwS3VQg767L1vbJYq",
+	"wN5AAPVMgpqJPIsLiJYMSBO3JpCd6OG4vaJ5GZF2r91CQUawBaG6QS+WJCZMKk1mopRkMQNLQbLMgRRU",
+	"j2co/NbCfZMm0onW5PjXBFHRQlZjaauFCxYjxJWfUovYUmSFJpK+VMCI0W8w1gYf4Xp3sPIPRIXjDDPt",
+	"fFlhJSWLGRvPjOw39EArVWHahuABN3L810RDgfCXEoEFyNU7dmn+n5UFy5g24Faiwv3/U6l0kiZzCWM2",
+	"Z9rruMbvMylGdMRy08OXyJI3afP4awURHYkrM/4IcrHo//QMJBMRTXNGpfbI8VgxAnM8g/FlSjK6VITK",
+	"iKjpEZ0eLA7X+uj5zCyecIsoCiHlKhjPAwrqQopNzEpV1GDouxBKW3lGDbBpTdPVbCZC4gNPjxd8RhU+",
+	"sURJEIXGEEAcNteeZKWB0c4Ucbh/YRcvlNhjCVRvxsPfg3ycV0S19jNHf20J2TJ7OPm//z1NSXGgUlIU",
+	"aJuBHJveSAZz4JlZCsGDxUuJhCmVGRp1YhKhyFsJs41kl0NDGpBBr5QKafzTHFVuBw3veb6sbFEyYZBn",
+	"lgnHM8qnkO136O9bW/Yha9LB32kuxpev3Ze1ALRS5tnRLCpYTiVkwDWjueoaWrmYMh6xhdNkTpVaCNkE",
+	"tnoY80JCErL9Br3EyOG0lBK4/gxUz0D26K1K+KLNHBW6TaPP6jPzUc7QEvDf7l/wN05FGSHKeMVaKUr1",
+	"DcR8VAIKnjEvuFdaxHa+p1X7m7Q58chq1Hp2mFFV6eJhzfPAKx/SPPQ3B7QXIwXyajPtMJegVCmHDuGE",
+	"RYSKfqEF1HLRtvJUIkGXkkOGLzOqaUqMs4MUNcqBG+njv7Hk8Ztg3JtIF8l/XCQx2EMbaRj4pXc8BxCO",
+	"dVJvrGX1I5MwjpsL1SucnWlsTDywPJQaQs9gKmGYigjsuOOvQ5tbE3CgtGuID0+PIa2lw2zPinB6zdAm",
+	"3tKAc5uM2CDcgMT8csVk2o+U5UvvhjyoSDPRj3llQ7N6lHuVcluWFFax4khMQ7GWJRroDlSt7ZdKSZf3",
+	"JB1qh+k+JMQt+H0Yi3gE3oZcXxs7KzQsAn77GdV5+IBe35Xvet2/rqfYz6Kq5JIpsP8p0FEbKEYmvcGD",
+	"yl1Cb0oYU9T8MsgHpZv2qJk0cL1/z9YA1S2G6QlMrTMbNjQDmli/xTfhIg7+fBM171d7sBnhiGJw+xaF",
+	"D4OqxSfDFWRDez8xHYw0F5MZrwrK8n+AZBM2riilTf2xXMSpyAJFA6YbYriHaIFPaJYZaljrXGDvUcik",
+	"FLIfmgj7aMpyFbyr+ypAKTqNf4d6VNNiPpSuYhMIu6mHi03rDYbsdkbFQxkVTXzvrIqNrYomAleZFfdp",
+	"xt+DORGzHaLUsN54wPSC0lRqE7GjGtnikcyJxw0uPH2rYnN/XW+UCXzqqh7hjjHy3z7/vatOaT6NktFY",
+	"XkWfx3XoJcviz/Uy+jxOuqWK934dz++Hk75EyXCJwW4zJdtZDxbOIZKlvoTlcKViUNnRIW2QTIcxCHz5",
+	"SReGLeZdtquUbVFBLPAsFIsHtDwWrKwUMsMU2GhJqk+qcRjXMO1LbeDQaa+uqztbnc3w4LyXzgZorgzL",
+	"VKysBXU8XIFctguGnPnEYWHnlqQ1Za1drZV0ZUBZNYPbpmFSW57zNiMS8D9FRkLPyFgImTFONaj9DY29",
+	"4VVZa0luk1Kkmh4Lev0O+FTPkuNnh4exwPStSpaiPkFnQX6BRTQP3MLgLiP6wBnR2yRDv8TXNxTlcfGG",
+	"dXiGhjICzBhzRsR5rvFFbpbwMWW/9Fy447UVvBYuJ44fXR+hq6jGOWhjukeEeNhKmdqJUoclGYa0VYpy",
+	"HNOgynrRLhPqZKeVoz76YfUBcDrKwbbgkO9f8Av+GUYzIS4VkTAGdgXk7P35R+88KOsP/u38/S9kJLIl",
+	"GQuuKeOGI1iWkkvGM+Og6xxS4iIMKea5EKRKve1f8I+1S0JMccgMKPqe/7XnINh7m6XBr48+dkH+7RNn",
+	"10SBcVHUv2PHdbNzNuVoV6cXvCo0ukjUjB69ePmfFwmZiDwXC6vGZ3BNgI+FUTZvfj453Tt/c3L04qVn",
+	"4SpckppKF2HrPc0LM/WUXMLSO8gLO/o5jCWYyZ0QHiwZGVNORkAyyNkVLlkhJBA9oxzraFKSlfPcNAZF",
+	"ZvQKcBBlXHoWrX3BSFakXMeGs9AjxCYNKFRKoJibVDTObon0kDGFJBAT41CH3SDCEZ9ngLKiDq0xRa5c",
+	"831yQgxezbMmzRkqxeYpopM3SduR6AXXgjBtnVc60W4Y3+NY8AmThcc+wrgMmQQjhhZzblojIXKg6J02",
+	"Fqs7L/vc04BrTJSnK2WIwUxCcIRlCtwIZQPLDLiZo//mk8w970V9tKpVpKT2wztcRT/6HdaxJYks6bQX",
+	"twFNG0ND5dbtjTo7Hwux8nOxK24lkwniuWHwcck9oTnSo1PKuCGdTLnluQrixUg4KxkpUAxHL56vXa2w",
+	"+eHzHwaZWmdOHp/irCOOlS1OOdusDCZNOCzO7lQ60x642WVs9bGIPjIDUXIt4960e3cajdO/PX9P/vTs",
+	"5cu9Z4Tm8xndO7Ks7njQ9xuZfMbUPKfLX2gR6fZ1mecY5vQdWeWNMoNp1R/+9y5rK5tgpXipIAsL2wPf",
+	"w3hUCxsPqzWmkXJNx0s9He93hbcaorZav6S5kj0+bZRmAnu4SzmmzmydCR8WoyHC+LR0CZPmQv319Iw8",
+	"/zPxDYim06gHywr4b8Fj9Hjyy0mDcKoMg88+BAttrRsJdZyduTL/24XLw80XaWsPxIZbH5qL6/eAtLdV",
+	"VHgIcJq6JVmzkvdXTrl1CkgJ7E/3CfC9T+f3Rw3Y56vSTOXgryBzxp/GuncW7QNMjMP4wUqlLgdK+/6j",
+	"uAS+Xk40Wsdo5ByUuq/wIVzPmQS1hYgjm0d1lZvdZlCWCuTJFLgeKGVrRDQHDOcb9orQRlEtxpegT0VR",
+	"UJ51EU7HY1CqWteWhjTDEtuEaNMGvdxSz4YqxQ+B0LNZpnm+9Pn1sQNqbZF9T1xCTPxuqpGNQ5S8+p2k",
+	"69fXPqjzgOHHza5wyl/WWUr4tn8Nfq4z+O0EBsi1TGx3phl690UFq1rbyoM2dzTx+Mq8spjUrBZfseUG",
+	"rtH/zJJ0IL2z3iBJsPI+BW1DZYgdpBAGZvxVdLFu/p5GNqGlYMmz25CP0/iYvjFrlSaN/lrdt9FqlxUV",
+	"ayPtE/oYVV36Si3YrGIfTqRaAi16NkBuRqKbr1JPfLPCZT/AQbV+PCq9CancD4oD6H2HMfhR6J5RJtdK",
+	"5YoUf1tEDcc12rnZ4NWm+rI1uxC21tB9A8VmH9gz0S3DNlJeJ8JVSnwW3VUBBRn54wvudo4xZcPzszMb",
+	"UyyKlDDTCaO5ffk6JYy/meJbxlOimHn+95RUX9hQgGfrKrzuu0nSRLEoh3aKHQLpUPJLLhYcORyoxL0o",
+	"UufL/xnnoszQc/L/TIRxSDLJfv8dvTVJ0W5UXCwwtF/yDKTSQharoOjZO36+LEYir2uvENXOaXUhrdpx",
+	"6RrineKJlRsl1u1EiFYmVGUDa7Rto2qn/rBRqtMEN0aHnd3r1brPlcnHF7MkTYq5+XvJRaPAKABMwbiU",
+	"TC/PjYSwmBoBlSBPjOVQ/fJ7pZK/ff7od6xj+BHf1qw303pu96YzPhGRUO7ZW7OAbqUJnc/Nt0zn5mP3",
+	"9AQfXoG0lnbybP9w/9Cl0jids+Q4+RM+MpSoZwjzwf4C8nwPKfXgt8Wl2v9N2WWexmKhpza8r1wgVi0L",
+	"x4WmcMBtdwfcIWpDeHYnqJENLpbLplUR2Zvzoxcv96ssltPXyU+gTbkDihY1F1xZ5B4dHroyJO1sajq3",
+	"MXIm+IEHetiJAK6cAtHdjvVioHdejnI3qX272mVRUBPJSs7qV8aqvaI5M5K0YURhPIeaxM2vodZHgjS9",
+	"HZiHB9XOt7mwDlgTD+/wteUAUPqvIlveGwLCHXk3TTbTsoSbLeK+1oER9J80kIW5ghLxOinzfN+Q8vPD",
+	"Z/cGijOZu2Cc+UAFLhEmfH3ujCmykMKKrudHf9k+LB+FIAXlSzKhLMf91kYMahVsqXYwmh/jnFnf0GXP",
+	"zLAfQMvl3olJmkSTG4JnipRcs9xVf1xrP4qZLrXpsdi5JXWNi4H7xeHh9tHxlmuQnObEbItiYyDgWoY8",
+	"GpCRMR0IXDOFqQLjPQ/lTVHqlcxp3m+HO1uhmUEM+jy2tBhyMWso4UpcmijbQ3GQm4LzKXGvK0pKQ6fW",
+	"Qc2SJ0UyHxBDToFZvDWrpzMiwzltQEV7NM/XUdJJnj9tYjrJc48Y5YLbO5IaRFKu0q5JVEYQEbHgPn+5",
+	"OW25L/oJy+HrCZHVAxkRH2tzs4qjPj6Zpp5IDb0uqNGrEmi2RE/saRHuq2ublSG0SZdocthkuv09Nysw",
+	"jFinTDn7o49aXYs/lsFrpy1XmLuHD2juYuZyQQOadfvTfFmXj9m6kiKUbMYuNWIMk3oW5gcwiz8ZAYpu",
+	"5LxprHu2QptPPTWNYIncMREqATrG/Hk/G9mcj3LsNAVxIA3iFQROepuX8P1PINw2v/AAzF+/rjitsskV",
+	"93d65dcVZzneaswhZzt+2SJT24KXGC+ZF7jZagZhJcgjcHO96m5vJPL0gynCkzCrxBQpmFKMT9Meu+35",
+	"4fPtw/RxBhKL9LggGPIi8571enTBYYY/2v7wTkhIg5SS0yvKclNr1whxotAIg5u/frn5Esq118zVwfZi",
+	"s5ZuUxCBKHPl0n2S7BxfI0+prhyL7XiVvoirUeLVc57oP1eKn0ZN32EkBtwj1VjROpw0gwktc50cvwgk",
+	"2FEowJ5Ftg3dVXwN2hPm5Fhn906HTl6L0qRgcCVsnHcESrvzKpU90HIn49bIuJ1A2USg+P1cS+IKELsi",
+	"BC2jgwIOMGe8Fx5/qnqFyjumdGePk0oegt06ww5hPfyocYqw8pk73KWHNacSELD9Hen3GOFDSc9QB6ER",
+	"lPtCFlsJ0Aklmy+MkZ72OLgnWdZd/e04u9E9fIO83vujmx4AWhHq4D1u47AlcI/l+bI7O75/ULO6qqnK",
+	"BCjChbbe98MGA7zjXx0sbC0tYv3BoHTQyftvSSidV0WPWpiElpVPeGK44J2LH2JiaZ22PPjKshtrrOag",
+	"oSu/fsTnMREWiy6Y+oLaJMZqugF+fs/1El8GZbtassTOI9v/QzNmAyld5vxm6N8SX035Ienasi6tiKP1",
+	"qE6OGoI/gX4i9Hz4sHq3a1Hu2OR7YJOfQPfwyHDj1Tj0XVax+3oej1vu30juOwn+gbNDt7KTSwT1CdjJ",
+	"O6nxHUgNu/+4voNGTOJCZJhZOSDusjaU+3PHdLddG+vXbqpM0o1jr4dh+ujwSURfq+0T60JAHztne2Dl",
+	"H9WgNB6pvgvAfq9RqPCuHV/yuU6NN7iy3mS+ijGrk80eJA4a7sBZR/vnza3yYQXVLtp5L3Sm4hgOqcwF",
+	"nJmsDobzRFd9tjr2+a6OTWwp5BlsLXvQSGdz3P4DtRDLO5PtUU02W53xSFHKihiaZUt4kECzaslX86M5",
+	"g3eLVryJxdx0PPvG5IwR4oRW8/AlAsCrDcK5WYq4WImrswNRnXpZRoug8H1Tr92/4GkewbkF53Hr+rR9",
+	"6OdORH3zWj3w6KoMceTEoeGsNiw5EOj4p5ETCNXvLh+wOlH3zeUC+pNeTZO0L/T/uNR6+CD2Z1PW72j/",
+	"uwjwDyX8laH8B6f+7ZlejxO4H+r27WL1T4r5hXRFyk/eCfwWLU7uarDDIzDFZKjIahif4Qm3eyo4CbtP",
+	"n0dPzt6iAIiOF0Fv2I74iTQvwrL1ZO5oW8KqE2+zelv+snla4s5Dursi5asWpifE7rdirdGuvbS4hchn",
+	"/8HLD6wR78YQhrx3uvI7i0DchcXWa4MDPK374Kp9EWQ0B9FzJvyWuLJ7Q+UTZcZXnYP6H4z5Tt3h/Xie",
+	"Tsco3DHf7ZnP3oYQXMRgEsfNixXQ/KyuUPA3MjC9jhHnwcnyntFaFIXGjMBjymKnXtTH0aREifo6Qi8O",
+	"BAeiNF0qf1gY4+52EP+5nQlk/uKTmTeMiJ5JUU79xPiETUsJWevujfoGgc9IeX5gPzV3MLUouTY2K7sE",
+	"f/aSvUzFHhbYlC5W3gUn5m9DqLQuDLjtUS5nwVlWTYPyIZi+heyGAKjyEPYmjafuuD7o8V8WSTWN7o7/",
+	"uqNtUlHgLcyRefPugj6XNLziYJuHEQTDRI3cnvuXXW2cal5WsHM5t+dyRq7Cvpuj2aawLSidzuUOD2zJ",
+	"bkDdOx/yO/QhN+eZhqj2h9atrH48940eoljDDTZo6/dYmxv3/Bx2tY/3X2Mbx/BtKGxglYRf/iezcfIR",
+	"jwl9kts6HD6+4TSxO3mStv3vAVTtzoI/cE1XWbetOxE69Bybft3k4J2/KOwmXd+2uklsUOPq9o8Brc/c",
+	"Ba8Dmtrz9Qc0bF2/tM3qj87FFL0et1tZMvZ3FTzGmWR5kBnf5aXbBTqrstN/hLODHBX7tPjdzhDqp3tf",
+	"fRxJR1e3tzSE4URIGFOlDzLK8uUqmfijafDaNd+JxF6RmHZOb6u2+mV0uX6jn73hXMW3+v053On3cs1G",
+	"vw4kr+3lhP74ODtQDVBqImLubWEOp/LD4s1bOV5nOqG5gjjg9urDJN3UaWgQ1mt/4kzTf0gTpZd4IYgx",
+	"DLerd5qEHj0wzr4LApWNKsTdJsWd4vk+FQ+yBpn00f9wbTMTpVytbt5gi52+uYu+MVi+o8I5eh5onOc/",
+	"fB8ap0lbT0DltIh9p3N2Omenc5zOsbxxB6Wj8GrN5Phr+HBheNjCIa/iR3UYSZ+TDK4gF/MCuCa2bZIm",
+	"pczdxXbHB7iXLJ8JpY9/OPzh8ODqGcoKB9PX6Hl+zbvA8NZ8F84qKKdTKGyq24m5ZvOIxH/vlaciEnKq",
+	"ITMi1sa+ql7sz5s0Nk37ra1kHi3bX9aFzN2vz02Bb33PojvXQfDIpjw/G2wS7YrK8QzvqLPH41J79DaT",
+	"rfO2XUdTEJFePodX1UvQksEVzeuvPI3cfLn5/wEA9KdvRk2zAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -2,28 +2,39 @@ package handlers
 
 import (
 	"context"
+	"log/slog"
 	"net/http"
-	"time"
+	"net/netip"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
 
 	"github.com/maxdikun/weatherapp/internal/handlers/gen"
+	"github.com/maxdikun/weatherapp/internal/models"
 	"github.com/maxdikun/weatherapp/internal/services"
 )
 
+// ApiHandler implements the operations of the API.
+//
+// Errors returned by the services are passed through as is, they are
+// translated into the responses by responseErrorHandler.
 type ApiHandler struct {
-	userSvc *services.UserService
+	userSvc     *services.UserService
+	weatherSvc  *services.WeatherService
+	locationSvc *services.LocationService
+	geoSvc      *services.GeoService
+	alertSvc    *services.AlertService
+
+	notificationSvc *services.NotificationService
+	streamSvc       *services.StreamService
 }
 
 var _ gen.StrictServerInterface = (*ApiHandler)(nil)
 
 // Register implements gen.StrictServerInterface.
 func (api *ApiHandler) Register(ctx context.Context, request gen.RegisterRequestObject) (gen.RegisterResponseObject, error) {
-	res, err := api.userSvc.Register(ctx, request.Body.Login, request.Body.Password)
+	res, err := api.userSvc.Register(ctx, request.Body.Login, request.Body.Password, clientInfoFromContext(ctx))
 	if err != nil {
-		return gen.Register500JSONResponse{
-			Code:      "INTERNAL_ERROR",
-			Timestamp: time.Now(),
-			Message:   "Internal service error occurred, try later",
-		}, nil
+		return nil, err
 	}
 
 	return gen.Register200JSONResponse{
@@ -33,13 +44,689 @@ func (api *ApiHandler) Register(ctx context.Context, request gen.RegisterRequest
 	}, nil
 }
 
-func SetupHandlers(userSvc *services.UserService) http.Handler {
-	apiH := &ApiHandler{userSvc: userSvc}
+// Login implements gen.StrictServerInterface.
+func (api *ApiHandler) Login(ctx context.Context, request gen.LoginRequestObject) (gen.LoginResponseObject, error) {
+	res, err := api.userSvc.Login(ctx, request.Body.Login, request.Body.Password, clientInfoFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.Login200JSONResponse{
+		AccessToken:           res.Access,
+		RefreshToken:          res.Refresh,
+		RefreshTokenExpiresAt: res.RefreshExpiresAt,
+	}, nil
+}
+
+// Refresh implements gen.StrictServerInterface.
+func (api *ApiHandler) Refresh(ctx context.Context, request gen.RefreshRequestObject) (gen.RefreshResponseObject, error) {
+	res, err := api.userSvc.Refresh(ctx, request.Body.RefreshToken, clientInfoFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.Refresh200JSONResponse{
+		AccessToken:           res.Access,
+		RefreshToken:          res.Refresh,
+		RefreshTokenExpiresAt: res.RefreshExpiresAt,
+	}, nil
+}
+
+// Logout implements gen.StrictServerInterface.
+func (api *ApiHandler) Logout(ctx context.Context, request gen.LogoutRequestObject) (gen.LogoutResponseObject, error) {
+	if err := api.userSvc.Logout(ctx, request.Body.RefreshToken); err != nil {
+		return nil, err
+	}
+
+	return gen.Logout204Response{}, nil
+}
+
+// LogoutAll implements gen.StrictServerInterface.
+func (api *ApiHandler) LogoutAll(ctx context.Context, request gen.LogoutAllRequestObject) (gen.LogoutAllResponseObject, error) {
+	if err := api.userSvc.LogoutAll(ctx, request.Body.RefreshToken); err != nil {
+		return nil, err
+	}
+
+	return gen.LogoutAll204Response{}, nil
+}
+
+// GetJWKS implements gen.StrictServerInterface.
+func (api *ApiHandler) GetJWKS(ctx context.Context, request gen.GetJWKSRequestObject) (gen.GetJWKSResponseObject, error) {
+	keys := api.userSvc.PublicKeys()
+
+	res := gen.GetJWKS200JSONResponse{Keys: make([]gen.JWK, len(keys))}
+	for i, key := range keys {
+		res.Keys[i] = gen.JWK{
+			Kty: key.Kty,
+			Kid: key.Kid,
+			Alg: key.Alg,
+			Use: key.Use,
+			N:   optional(key.N),
+			E:   optional(key.E),
+			Crv: optional(key.Crv),
+			X:   optional(key.X),
+		}
+	}
+
+	return res, nil
+}
+
+// ListSessions implements gen.StrictServerInterface.
+func (api *ApiHandler) ListSessions(ctx context.Context, request gen.ListSessionsRequestObject) (gen.ListSessionsResponseObject, error) {
+	sessions, err := api.userSvc.ListSessions(ctx, userFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(gen.ListSessions200JSONResponse, len(sessions))
+	for i, session := range sessions {
+		res[i] = gen.Session{
+			Id:          session.Id,
+			CreatedAt:   session.CreatedAt,
+			RefreshedAt: session.RefreshedAt,
+			ExpiresAt:   session.ExpiresAt,
+			UserAgent:   session.UserAgent,
+			Ip:          session.IP,
+		}
+	}
+
+	return res, nil
+}
+
+// DeleteSession implements gen.StrictServerInterface.
+func (api *ApiHandler) DeleteSession(ctx context.Context, request gen.DeleteSessionRequestObject) (gen.DeleteSessionResponseObject, error) {
+	if err := api.userSvc.DeleteSession(ctx, userFromContext(ctx), request.Id); err != nil {
+		return nil, err
+	}
+
+	return gen.DeleteSession204Response{}, nil
+}
+
+// GetPreferences implements gen.StrictServerInterface.
+func (api *ApiHandler) GetPreferences(ctx context.Context, request gen.GetPreferencesRequestObject) (gen.GetPreferencesResponseObject, error) {
+	preferences, err := api.userSvc.Preferences(ctx, userFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.GetPreferences200JSONResponse(toPreferences(preferences)), nil
+}
+
+// UpdatePreferences implements gen.StrictServerInterface.
+func (api *ApiHandler) UpdatePreferences(ctx context.Context, request gen.UpdatePreferencesRequestObject) (gen.UpdatePreferencesResponseObject, error) {
+	preferences, err := api.userSvc.UpdatePreferences(ctx, userFromContext(ctx), services.PreferencesUpdate{
+		Units:         (*models.UnitSystem)(request.Body.Units),
+		WindSpeedUnit: (*models.WindSpeedUnit)(request.Body.WindSpeedUnit),
+		TimeZone:      request.Body.TimeZone,
+		Language:      request.Body.Language,
+		Clock:         (*models.ClockFormat)(request.Body.Clock),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.UpdatePreferences200JSONResponse(toPreferences(preferences)), nil
+}
+
+// ChangePassword implements gen.StrictServerInterface.
+func (api *ApiHandler) ChangePassword(ctx context.Context, request gen.ChangePasswordRequestObject) (gen.ChangePasswordResponseObject, error) {
+	err := api.userSvc.ChangePassword(
+		ctx,
+		userFromContext(ctx),
+		sessionFromContext(ctx),
+		request.Body.CurrentPassword,
+		request.Body.NewPassword,
+		clientInfoFromContext(ctx),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.ChangePassword204Response{}, nil
+}
+
+// GetNotificationSettings implements gen.StrictServerInterface.
+func (api *ApiHandler) GetNotificationSettings(ctx context.Context, request gen.GetNotificationSettingsRequestObject) (gen.GetNotificationSettingsResponseObject, error) {
+	settings, err := api.notificationSvc.Settings(ctx, userFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.GetNotificationSettings200JSONResponse(toNotificationSettings(settings)), nil
+}
+
+// UpdateNotificationSettings implements gen.StrictServerInterface.
+func (api *ApiHandler) UpdateNotificationSettings(ctx context.Context, request gen.UpdateNotificationSettingsRequestObject) (gen.UpdateNotificationSettingsResponseObject, error) {
+	settings, err := api.notificationSvc.UpdateSettings(ctx, userFromContext(ctx), services.NotificationSettingsUpdate{
+		Email:      request.Body.Email,
+		WebhookURL: request.Body.WebhookUrl,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.UpdateNotificationSettings200JSONResponse(toNotificationSettings(settings)), nil
+}
+
+// VerifyNotificationEmail implements gen.StrictServerInterface.
+func (api *ApiHandler) VerifyNotificationEmail(ctx context.Context, request gen.VerifyNotificationEmailRequestObject) (gen.VerifyNotificationEmailResponseObject, error) {
+	settings, err := api.notificationSvc.VerifyEmail(ctx, userFromContext(ctx), request.Body.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.VerifyNotificationEmail200JSONResponse(toNotificationSettings(settings)), nil
+}
+
+func toNotificationSettings(settings models.NotificationSettings) gen.NotificationSettings {
+	return gen.NotificationSettings{
+		Email:         settings.Email,
+		EmailVerified: settings.EmailVerified,
+		WebhookUrl:    settings.WebhookURL,
+		WebhookSecret: settings.WebhookSecret,
+	}
+}
+
+func toPreferences(preferences models.Preferences) gen.Preferences {
+	return gen.Preferences{
+		Units:         gen.UnitSystem(preferences.Units.System),
+		WindSpeedUnit: gen.WindSpeedUnit(preferences.Units.WindSpeed),
+		TimeZone:      preferences.TimeZone,
+		Language:      preferences.Language,
+		Clock:         gen.ClockFormat(preferences.Clock),
+	}
+}
+
+// ListLocations implements gen.StrictServerInterface.
+func (api *ApiHandler) ListLocations(ctx context.Context, request gen.ListLocationsRequestObject) (gen.ListLocationsResponseObject, error) {
+	locations, err := api.locationSvc.List(ctx, userFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.ListLocations200JSONResponse(toLocations(locations)), nil
+}
+
+// AddLocation implements gen.StrictServerInterface.
+func (api *ApiHandler) AddLocation(ctx context.Context, request gen.AddLocationRequestObject) (gen.AddLocationResponseObject, error) {
+	location, err := api.locationSvc.Add(ctx, userFromContext(ctx), services.NewLocation{
+		Name:      request.Body.Name,
+		Latitude:  request.Body.Latitude,
+		Longitude: request.Body.Longitude,
+		PlaceId:   request.Body.PlaceId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.AddLocation201JSONResponse(toLocation(location)), nil
+}
+
+// ReorderLocations implements gen.StrictServerInterface.
+func (api *ApiHandler) ReorderLocations(ctx context.Context, request gen.ReorderLocationsRequestObject) (gen.ReorderLocationsResponseObject, error) {
+	locations, err := api.locationSvc.Reorder(ctx, userFromContext(ctx), request.Body.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.ReorderLocations200JSONResponse(toLocations(locations)), nil
+}
+
+// GetLocation implements gen.StrictServerInterface.
+func (api *ApiHandler) GetLocation(ctx context.Context, request gen.GetLocationRequestObject) (gen.GetLocationResponseObject, error) {
+	location, err := api.locationSvc.Get(ctx, userFromContext(ctx), request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.GetLocation200JSONResponse(toLocation(location)), nil
+}
+
+// UpdateLocation implements gen.StrictServerInterface.
+func (api *ApiHandler) UpdateLocation(ctx context.Context, request gen.UpdateLocationRequestObject) (gen.UpdateLocationResponseObject, error) {
+	location, err := api.locationSvc.Update(ctx, userFromContext(ctx), request.Id, services.LocationUpdate{
+		Name:      request.Body.Name,
+		Latitude:  request.Body.Latitude,
+		Longitude: request.Body.Longitude,
+		PlaceId:   request.Body.PlaceId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.UpdateLocation200JSONResponse(toLocation(location)), nil
+}
+
+// DeleteLocation implements gen.StrictServerInterface.
+func (api *ApiHandler) DeleteLocation(ctx context.Context, request gen.DeleteLocationRequestObject) (gen.DeleteLocationResponseObject, error) {
+	if err := api.locationSvc.Delete(ctx, userFromContext(ctx), request.Id); err != nil {
+		return nil, err
+	}
+
+	return gen.DeleteLocation204Response{}, nil
+}
+
+func toLocation(location models.Location) gen.Location {
+	return gen.Location{
+		Id:        location.Id,
+		Name:      location.Name,
+		Latitude:  location.Coordinates.Latitude,
+		Longitude: location.Coordinates.Longitude,
+		Position:  location.Position,
+		CreatedAt: location.CreatedAt,
+	}
+}
+
+func toLocations(locations []models.Location) []gen.Location {
+	result := make([]gen.Location, len(locations))
+	for i, location := range locations {
+		result[i] = toLocation(location)
+	}
+	return result
+}
+
+// ListAlertSubscriptions implements gen.StrictServerInterface.
+func (api *ApiHandler) ListAlertSubscriptions(ctx context.Context, request gen.ListAlertSubscriptionsRequestObject) (gen.ListAlertSubscriptionsResponseObject, error) {
+	subscriptions, err := api.alertSvc.ListSubscriptions(ctx, userFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(gen.ListAlertSubscriptions200JSONResponse, len(subscriptions))
+	for i, subscription := range subscriptions {
+		res[i] = toAlertSubscription(subscription)
+	}
+
+	return res, nil
+}
+
+// AddAlertSubscription implements gen.StrictServerInterface.
+func (api *ApiHandler) AddAlertSubscription(ctx context.Context, request gen.AddAlertSubscriptionRequestObject) (gen.AddAlertSubscriptionResponseObject, error) {
+	subscription, err := api.alertSvc.AddSubscription(ctx, userFromContext(ctx), services.NewAlertSubscription{
+		LocationId: request.Body.LocationId,
+		Metric:     models.AlertMetric(request.Body.Metric),
+		Operator:   models.AlertOperator(request.Body.Operator),
+		Threshold:  request.Body.Threshold,
+		Period:     models.AlertPeriod(request.Body.Period),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.AddAlertSubscription201JSONResponse(toAlertSubscription(subscription)), nil
+}
+
+// GetAlertSubscription implements gen.StrictServerInterface.
+func (api *ApiHandler) GetAlertSubscription(ctx context.Context, request gen.GetAlertSubscriptionRequestObject) (gen.GetAlertSubscriptionResponseObject, error) {
+	subscription, err := api.alertSvc.GetSubscription(ctx, userFromContext(ctx), request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.GetAlertSubscription200JSONResponse(toAlertSubscription(subscription)), nil
+}
+
+// UpdateAlertSubscription implements gen.StrictServerInterface.
+func (api *ApiHandler) UpdateAlertSubscription(ctx context.Context, request gen.UpdateAlertSubscriptionRequestObject) (gen.UpdateAlertSubscriptionResponseObject, error) {
+	subscription, err := api.alertSvc.UpdateSubscription(ctx, userFromContext(ctx), request.Id, services.AlertSubscriptionUpdate{
+		Metric:    (*models.AlertMetric)(request.Body.Metric),
+		Operator:  (*models.AlertOperator)(request.Body.Operator),
+		Threshold: request.Body.Threshold,
+		Period:    (*models.AlertPeriod)(request.Body.Period),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.UpdateAlertSubscription200JSONResponse(toAlertSubscription(subscription)), nil
+}
+
+// DeleteAlertSubscription implements gen.StrictServerInterface.
+func (api *ApiHandler) DeleteAlertSubscription(ctx context.Context, request gen.DeleteAlertSubscriptionRequestObject) (gen.DeleteAlertSubscriptionResponseObject, error) {
+	if err := api.alertSvc.DeleteSubscription(ctx, userFromContext(ctx), request.Id); err != nil {
+		return nil, err
+	}
+
+	return gen.DeleteAlertSubscription204Response{}, nil
+}
+
+// ListAlerts implements gen.StrictServerInterface.
+func (api *ApiHandler) ListAlerts(ctx context.Context, request gen.ListAlertsRequestObject) (gen.ListAlertsResponseObject, error) {
+	alerts, err := api.alertSvc.ListAlerts(ctx, userFromContext(ctx), valueOr(request.Params.Limit, defaultListedAlerts))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(gen.ListAlerts200JSONResponse, len(alerts))
+	for i, alert := range alerts {
+		res[i] = toAlert(alert)
+	}
+
+	return res, nil
+}
+
+func toAlert(alert models.Alert) gen.Alert {
+	return gen.Alert{
+		Id:             alert.Id,
+		SubscriptionId: alert.Subscription,
+		LocationId:     alert.Location,
+		Metric:         gen.AlertMetric(alert.Metric),
+		Operator:       gen.AlertOperator(alert.Operator),
+		Threshold:      alert.Threshold,
+		Value:          alert.Value,
+		ForecastTime:   alert.ForecastTime,
+		Day:            openapi_types.Date{Time: alert.Day},
+		TriggeredAt:    alert.TriggeredAt,
+	}
+}
+
+func toAlertSubscription(subscription models.AlertSubscription) gen.AlertSubscription {
+	return gen.AlertSubscription{
+		Id:         subscription.Id,
+		LocationId: subscription.Location,
+		Metric:     gen.AlertMetric(subscription.Metric),
+		Operator:   gen.AlertOperator(subscription.Operator),
+		Threshold:  subscription.Threshold,
+		Period:     gen.AlertPeriod(subscription.Period),
+		CreatedAt:  subscription.CreatedAt,
+	}
+}
+
+// SearchPlaces implements gen.StrictServerInterface.
+func (api *ApiHandler) SearchPlaces(ctx context.Context, request gen.SearchPlacesRequestObject) (gen.SearchPlacesResponseObject, error) {
+	places, err := api.geoSvc.Search(ctx, request.Params.Q, valueOr(request.Params.Limit, defaultPlaceSearchResults))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(gen.SearchPlaces200JSONResponse, len(places))
+	for i, place := range places {
+		res[i] = toPlace(place)
+	}
+
+	return res, nil
+}
+
+// ReverseGeocode implements gen.StrictServerInterface.
+func (api *ApiHandler) ReverseGeocode(ctx context.Context, request gen.ReverseGeocodeRequestObject) (gen.ReverseGeocodeResponseObject, error) {
+	place, err := api.geoSvc.Reverse(ctx, models.Coordinates{
+		Latitude:  request.Params.Lat,
+		Longitude: request.Params.Lon,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.ReverseGeocode200JSONResponse(toPlace(place)), nil
+}
+
+func toPlace(place models.Place) gen.Place {
+	return gen.Place{
+		Id:          place.Id,
+		Name:        place.Name,
+		DisplayName: place.DisplayName,
+		Country:     place.Country,
+		CountryCode: place.CountryCode,
+		Latitude:    place.Coordinates.Latitude,
+		Longitude:   place.Coordinates.Longitude,
+	}
+}
+
+// GetCurrentWeather implements gen.StrictServerInterface.
+func (api *ApiHandler) GetCurrentWeather(ctx context.Context, request gen.GetCurrentWeatherRequestObject) (gen.GetCurrentWeatherResponseObject, error) {
+	coordinates, err := api.locationSvc.Resolve(ctx, userFromContext(ctx), services.LocationQuery{
+		Latitude:   request.Params.Lat,
+		Longitude:  request.Params.Lon,
+		LocationId: request.Params.Location,
+		PlaceId:    request.Params.Place,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	preferences, err := api.weatherPreferences(ctx, request.Params.Units, request.Params.WindSpeedUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	weather, err := api.weatherSvc.Current(ctx, coordinates)
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.GetCurrentWeather200JSONResponse(toCurrentWeather(weather, preferences)), nil
+}
+
+// toCurrentWeather converts the weather into the units and the time zone from the preferences.
+func toCurrentWeather(weather models.CurrentWeather, preferences models.Preferences) gen.CurrentWeather {
+	converter := services.NewUnitConverter(preferences.Units)
+	weather = converter.CurrentWeather(weather)
+
+	return gen.CurrentWeather{
+		Latitude:      weather.Coordinates.Latitude,
+		Longitude:     weather.Coordinates.Longitude,
+		Temperature:   weather.Temperature,
+		FeelsLike:     weather.FeelsLike,
+		Humidity:      weather.Humidity,
+		Pressure:      weather.Pressure,
+		WindSpeed:     weather.WindSpeed,
+		WindGust:      weather.WindGust,
+		WindDirection: weather.WindDirection,
+		Condition:     gen.WeatherCondition(weather.Condition),
+		Description:   weather.Description,
+		ObservedAt:    weather.ObservedAt.In(preferences.Location()),
+		Provider:      weather.Provider,
+		Units:         toWeatherUnits(converter.Symbols()),
+	}
+}
+
+// GetHourlyForecast implements gen.StrictServerInterface.
+func (api *ApiHandler) GetHourlyForecast(ctx context.Context, request gen.GetHourlyForecastRequestObject) (gen.GetHourlyForecastResponseObject, error) {
+	fields, err := newFieldSet(request.Params.Fields, hourlyForecastFields)
+	if err != nil {
+		return nil, err
+	}
+
+	coordinates, err := api.locationSvc.Resolve(ctx, userFromContext(ctx), services.LocationQuery{
+		Latitude:   request.Params.Lat,
+		Longitude:  request.Params.Lon,
+		LocationId: request.Params.Location,
+		PlaceId:    request.Params.Place,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	preferences, err := api.weatherPreferences(ctx, request.Params.Units, request.Params.WindSpeedUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	forecast, err := api.weatherSvc.HourlyForecast(ctx, coordinates, valueOr(request.Params.Periods, defaultForecastHours))
+	if err != nil {
+		return nil, err
+	}
+
+	converter := services.NewUnitConverter(preferences.Units)
+	forecast = converter.HourlyForecast(forecast)
+	location := preferences.Location()
+
+	periods := make([]gen.HourlyForecastPeriod, len(forecast))
+	for i, f := range forecast {
+		periods[i] = gen.HourlyForecastPeriod{
+			Time:                     f.Time.In(location),
+			Temperature:              pick(fields, gen.HourlyForecastFieldTemperature, f.Temperature),
+			FeelsLike:                pick(fields, gen.HourlyForecastFieldFeelsLike, f.FeelsLike),
+			Humidity:                 pick(fields, gen.HourlyForecastFieldHumidity, f.Humidity),
+			Pressure:                 pick(fields, gen.HourlyForecastFieldPressure, f.Pressure),
+			WindSpeed:                pick(fields, gen.HourlyForecastFieldWindSpeed, f.WindSpeed),
+			WindGust:                 pick(fields, gen.HourlyForecastFieldWindGust, f.WindGust),
+			WindDirection:            pick(fields, gen.HourlyForecastFieldWindDirection, f.WindDirection),
+			PrecipitationProbability: pick(fields, gen.HourlyForecastFieldPrecipitationProbability, f.PrecipitationProbability),
+			Precipitation:            pick(fields, gen.HourlyForecastFieldPrecipitation, f.Precipitation),
+			Condition:                pick(fields, gen.HourlyForecastFieldCondition, gen.WeatherCondition(f.Condition)),
+			Description:              pick(fields, gen.HourlyForecastFieldDescription, f.Description),
+		}
+	}
+
+	var provider string
+	if len(forecast) != 0 {
+		provider = forecast[0].Provider
+	}
+
+	return gen.GetHourlyForecast200JSONResponse{
+		Latitude:  coordinates.Latitude,
+		Longitude: coordinates.Longitude,
+		Periods:   periods,
+		Provider:  provider,
+		Units:     toWeatherUnits(converter.Symbols()),
+	}, nil
+}
+
+// GetDailyForecast implements gen.StrictServerInterface.
+func (api *ApiHandler) GetDailyForecast(ctx context.Context, request gen.GetDailyForecastRequestObject) (gen.GetDailyForecastResponseObject, error) {
+	fields, err := newFieldSet(request.Params.Fields, dailyForecastFields)
+	if err != nil {
+		return nil, err
+	}
+
+	coordinates, err := api.locationSvc.Resolve(ctx, userFromContext(ctx), services.LocationQuery{
+		Latitude:   request.Params.Lat,
+		Longitude:  request.Params.Lon,
+		LocationId: request.Params.Location,
+		PlaceId:    request.Params.Place,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	preferences, err := api.weatherPreferences(ctx, request.Params.Units, request.Params.WindSpeedUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	forecast, err := api.weatherSvc.DailyForecast(ctx, coordinates, valueOr(request.Params.Periods, defaultForecastDays))
+	if err != nil {
+		return nil, err
+	}
+
+	converter := services.NewUnitConverter(preferences.Units)
+	forecast = converter.DailyForecast(forecast)
+	location := preferences.Location()
+
+	periods := make([]gen.DailyForecastPeriod, len(forecast))
+	for i, f := range forecast {
+		periods[i] = gen.DailyForecastPeriod{
+			Date:                     openapi_types.Date{Time: f.Date},
+			TemperatureMin:           pick(fields, gen.DailyForecastFieldTemperatureMin, f.TemperatureMin),
+			TemperatureMax:           pick(fields, gen.DailyForecastFieldTemperatureMax, f.TemperatureMax),
+			Humidity:                 pick(fields, gen.DailyForecastFieldHumidity, f.Humidity),
+			Pressure:                 pick(fields, gen.DailyForecastFieldPressure, f.Pressure),
+			WindSpeed:                pick(fields, gen.DailyForecastFieldWindSpeed, f.WindSpeed),
+			WindGust:                 pick(fields, gen.DailyForecastFieldWindGust, f.WindGust),
+			WindDirection:            pick(fields, gen.DailyForecastFieldWindDirection, f.WindDirection),
+			PrecipitationProbability: pick(fields, gen.DailyForecastFieldPrecipitationProbability, f.PrecipitationProbability),
+			Precipitation:            pick(fields, gen.DailyForecastFieldPrecipitation, f.Precipitation),
+			Condition:                pick(fields, gen.DailyForecastFieldCondition, gen.WeatherCondition(f.Condition)),
+			Description:              pick(fields, gen.DailyForecastFieldDescription, f.Description),
+			Sunrise:                  pick(fields, gen.DailyForecastFieldSunrise, f.Sunrise.In(location)),
+			Sunset:                   pick(fields, gen.DailyForecastFieldSunset, f.Sunset.In(location)),
+		}
+	}
+
+	var provider string
+	if len(forecast) != 0 {
+		provider = forecast[0].Provider
+	}
+
+	return gen.GetDailyForecast200JSONResponse{
+		Latitude:  coordinates.Latitude,
+		Longitude: coordinates.Longitude,
+		Periods:   periods,
+		Provider:  provider,
+		Units:     toWeatherUnits(converter.Symbols()),
+	}, nil
+}
+
+// weatherPreferences returns the preferences of the caller with the units overridden by the request.
+func (api *ApiHandler) weatherPreferences(ctx context.Context, system *gen.Units, windSpeed *gen.WindSpeedUnit) (models.Preferences, error) {
+	preferences, err := api.userSvc.Preferences(ctx, userFromContext(ctx))
+	if err != nil {
+		return models.Preferences{}, err
+	}
+
+	preferences.Units, err = services.OverrideUnits(preferences.Units, (*models.UnitSystem)(system), (*models.WindSpeedUnit)(windSpeed))
+	if err != nil {
+		return models.Preferences{}, err
+	}
+	return preferences, nil
+}
+
+func toWeatherUnits(symbols services.UnitSymbols) gen.WeatherUnits {
+	return gen.WeatherUnits{
+		Temperature:   symbols.Temperature,
+		WindSpeed:     symbols.WindSpeed,
+		Pressure:      symbols.Pressure,
+		Precipitation: symbols.Precipitation,
+	}
+}
 
-	api := gen.NewStrictHandler(apiH, nil)
+// optional returns nil for zero values, so they are omitted from the response.
+func optional[T comparable](v T) *T {
+	var zero T
+	if v == zero {
+		return nil
+	}
+	return &v
+}
+
+// SetupHandlers creates the handler of the API. Requests are not throttled if rateLimiter is nil.
+// X-Forwarded-For is used to find the IP of the client only behind trustedProxies.
+func SetupHandlers(
+	logger *slog.Logger,
+	userSvc *services.UserService,
+	weatherSvc *services.WeatherService,
+	locationSvc *services.LocationService,
+	geoSvc *services.GeoService,
+	alertSvc *services.AlertService,
+	notificationSvc *services.NotificationService,
+	streamSvc *services.StreamService,
+	rateLimiter *services.RateLimiter,
+	trustedProxies []netip.Prefix,
+) http.Handler {
+	apiH := &ApiHandler{
+		userSvc:         userSvc,
+		weatherSvc:      weatherSvc,
+		locationSvc:     locationSvc,
+		geoSvc:          geoSvc,
+		alertSvc:        alertSvc,
+		notificationSvc: notificationSvc,
+		streamSvc:       streamSvc,
+	}
+
+	api := gen.NewStrictHandlerWithOptions(
+		apiH,
+		[]gen.StrictMiddlewareFunc{clientInfoMiddleware, authMiddleware(userSvc)},
+		gen.StrictHTTPServerOptions{
+			RequestErrorHandlerFunc:  requestErrorHandler,
+			ResponseErrorHandlerFunc: responseErrorHandler,
+		},
+	)
 
 	mux := http.NewServeMux()
-	gen.HandlerFromMux(api, mux)
+	gen.HandlerWithOptions(api, gen.StdHTTPServerOptions{
+		BaseRouter:       mux,
+		ErrorHandlerFunc: requestErrorHandler,
+	})
+	mux.HandleFunc("GET /weather/stream", apiH.WeatherStream)
+	mux.HandleFunc("GET /weather/ws", apiH.WeatherSocket)
 
-	return mux
+	var handler http.Handler = mux
+	if rateLimiter != nil {
+		handler = rateLimitMiddleware(logger, rateLimiter, userSvc, mux)
+	}
+	if len(trustedProxies) != 0 {
+		handler = forwardedMiddleware(trustedProxies, handler)
+	}
+	return handler
 }
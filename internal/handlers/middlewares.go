@@ -0,0 +1,179 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/maxdikun/weatherapp/internal/handlers/gen"
+	"github.com/maxdikun/weatherapp/internal/services"
+)
+
+type clientInfoKey struct{}
+
+type clientIPKey struct{}
+
+type userKey struct{}
+
+type sessionKey struct{}
+
+// clientInfoMiddleware stores the information about the client into the context.
+func clientInfoMiddleware(f gen.StrictHandlerFunc, operationID string) gen.StrictHandlerFunc {
+	return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		ctx = context.WithValue(ctx, clientInfoKey{}, services.ClientInfo{
+			UserAgent: r.UserAgent(),
+			IP:        clientIP(r),
+		})
+
+		return f(ctx, w, r, request)
+	}
+}
+
+// authMiddleware authenticates requests to the operations, which are protected by the bearerAuth
+// security scheme, and stores the ids of the authenticated user and their session into the context.
+func authMiddleware(userSvc *services.UserService) gen.StrictMiddlewareFunc {
+	return func(f gen.StrictHandlerFunc, operationID string) gen.StrictHandlerFunc {
+		return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+			if _, ok := ctx.Value(gen.BearerAuthScopes).([]string); !ok {
+				return f(ctx, w, r, request)
+			}
+
+			token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok {
+				return nil, services.ErrInvalidToken
+			}
+
+			user, session, err := userSvc.AuthenticateSession(token)
+			if err != nil {
+				return nil, err
+			}
+
+			ctx = context.WithValue(ctx, userKey{}, user)
+			ctx = context.WithValue(ctx, sessionKey{}, session)
+			return f(ctx, w, r, request)
+		}
+	}
+}
+
+// rateLimitMiddleware throttles every request served by the mux and reports the budget in RateLimit-* headers.
+// If the limiter fails, requests are let through, so the outage of the storage doesn't take the API down.
+func rateLimitMiddleware(
+	logger *slog.Logger,
+	limiter *services.RateLimiter,
+	userSvc *services.UserService,
+	mux *http.ServeMux,
+) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, route := mux.Handler(r)
+
+		// The token is validated again by authMiddleware, here it only selects the budget.
+		user := uuid.Nil
+		if token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+			user, _ = userSvc.Authenticate(token)
+		}
+
+		status, err := limiter.Allow(r.Context(), route, clientIP(r), user)
+		if err != nil {
+			var limited *services.RateLimitError
+			if !errors.As(err, &limited) {
+				logger.Error("Rate limiter failed", "err", err)
+				mux.ServeHTTP(w, r)
+				return
+			}
+		}
+
+		if status.Limit != 0 {
+			w.Header().Set("RateLimit-Limit", strconv.Itoa(status.Limit))
+			w.Header().Set("RateLimit-Remaining", strconv.Itoa(status.Remaining))
+			w.Header().Set("RateLimit-Reset", formatSeconds(status.Reset))
+		}
+
+		if err != nil {
+			responseErrorHandler(w, r, err)
+			return
+		}
+
+		mux.ServeHTTP(w, r)
+	})
+}
+
+func clientInfoFromContext(ctx context.Context) services.ClientInfo {
+	client, _ := ctx.Value(clientInfoKey{}).(services.ClientInfo)
+	return client
+}
+
+// userFromContext returns the id of the user, authenticated by authMiddleware.
+func userFromContext(ctx context.Context) uuid.UUID {
+	user, _ := ctx.Value(userKey{}).(uuid.UUID)
+	return user
+}
+
+// forwardedMiddleware takes the IP of the client from X-Forwarded-For, if the request came through
+// the trusted proxies. The addresses are checked from the right and the first untrusted one is the client,
+// because the ones on its left are sent by the client itself and could be forged.
+func forwardedMiddleware(trustedProxies []netip.Prefix, next http.Handler) http.Handler {
+	trusted := func(addr netip.Addr) bool {
+		return slices.ContainsFunc(trustedProxies, func(p netip.Prefix) bool { return p.Contains(addr.Unmap()) })
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		remote, err := netip.ParseAddrPort(r.RemoteAddr)
+		if err != nil || !trusted(remote.Addr()) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		var hops []string
+		for _, header := range r.Header.Values("X-Forwarded-For") {
+			for _, hop := range strings.Split(header, ",") {
+				hops = append(hops, strings.TrimSpace(hop))
+			}
+		}
+
+		ip := ""
+		for i := len(hops) - 1; i >= 0; i-- {
+			addr, err := netip.ParseAddr(hops[i])
+			if err != nil {
+				// The header is malformed, so the address of the proxy is used.
+				ip = ""
+				break
+			}
+			ip = addr.Unmap().String()
+			if !trusted(addr) {
+				break
+			}
+		}
+
+		if ip != "" {
+			r = r.WithContext(context.WithValue(r.Context(), clientIPKey{}, ip))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// sessionFromContext returns the id of the session, which issued the access token, authenticated by authMiddleware.
+func sessionFromContext(ctx context.Context) uuid.UUID {
+	session, _ := ctx.Value(sessionKey{}).(uuid.UUID)
+	return session
+}
+
+// clientIP returns the IP resolved by forwardedMiddleware, or the address of the peer.
+func clientIP(r *http.Request) string {
+	if ip, ok := r.Context().Value(clientIPKey{}).(string); ok {
+		return ip
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
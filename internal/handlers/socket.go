@@ -0,0 +1,285 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+	"github.com/google/uuid"
+
+	"github.com/maxdikun/weatherapp/internal/handlers/gen"
+	"github.com/maxdikun/weatherapp/internal/models"
+	"github.com/maxdikun/weatherapp/internal/services"
+)
+
+const (
+	// socketPingInterval is the interval of the pings, which detect the dead connections
+	// and keep idle ones from being closed by the proxies.
+	socketPingInterval = 30 * time.Second
+	// socketWriteTimeout limits every write, the connection of the client, which doesn't read, is closed after it.
+	socketWriteTimeout = 10 * time.Second
+	// socketReadLimit is the maximum size of the command, the commands are small, so larger messages are rejected.
+	socketReadLimit = 4096
+	// socketReplyBuffer is the number of the replies, which wait for the writer, before the commands aren't read.
+	socketReplyBuffer = 16
+)
+
+// WeatherSocket serves the WeatherSocket operation over WebSocket. It is excluded from
+// the generated server, because the strict handlers can't upgrade the connection.
+func (api *ApiHandler) WeatherSocket(w http.ResponseWriter, r *http.Request) {
+	// The operation is protected by bearerAuth, so the errors are reported like in the generated handlers.
+	r = r.WithContext(context.WithValue(r.Context(), gen.BearerAuthScopes, []string{}))
+
+	user, expiresAt, err := api.userSvc.AuthenticateUntil(accessTokenFromRequest(r))
+	if err != nil {
+		responseErrorHandler(w, r, err)
+		return
+	}
+	ctx, cancel := context.WithCancel(context.WithValue(r.Context(), userKey{}, user))
+	defer cancel()
+
+	query := r.URL.Query()
+	preferences, err := api.weatherPreferences(
+		ctx,
+		optionalQuery[gen.Units](query, "units"),
+		optionalQuery[gen.WindSpeedUnit](query, "windSpeedUnit"),
+	)
+	if err != nil {
+		responseErrorHandler(w, r, err)
+		return
+	}
+
+	stream, err := api.streamSvc.Open(ctx, user)
+	if err != nil {
+		responseErrorHandler(w, r, err)
+		return
+	}
+	defer stream.Close()
+
+	// Accept replies to the invalid handshakes itself.
+	conn, err := websocket.Accept(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.CloseNow()
+	conn.SetReadLimit(socketReadLimit)
+
+	s := &socket{
+		api:         api,
+		conn:        conn,
+		stream:      stream,
+		user:        user,
+		preferences: preferences,
+		replies:     make(chan gen.SocketMessage, socketReplyBuffer),
+		expiries:    make(chan time.Time),
+	}
+
+	go func() {
+		s.read(ctx)
+		cancel()
+	}()
+	go s.ping(ctx, cancel)
+
+	if err := s.write(ctx, expiresAt); err != nil {
+		s.close(err)
+	}
+}
+
+// socket is the WebSocket connection of the client. Commands are read by one goroutine,
+// while the replies and the updates of the stream are written by another one.
+type socket struct {
+	api         *ApiHandler
+	conn        *websocket.Conn
+	stream      *services.Stream
+	user        uuid.UUID
+	preferences models.Preferences
+
+	replies chan gen.SocketMessage
+	// expiries passes the expiration time of the fresh token to the writer.
+	expiries chan time.Time
+}
+
+// read handles the commands until the connection is closed.
+func (s *socket) read(ctx context.Context) {
+	for {
+		typ, payload, err := s.conn.Read(ctx)
+		if err != nil {
+			return
+		}
+
+		var command gen.SocketCommand
+		if typ != websocket.MessageText {
+			err = errors.New("commands should be sent as text messages")
+		} else {
+			err = json.Unmarshal(payload, &command)
+		}
+
+		var reply gen.SocketMessage
+		if err != nil {
+			reply = socketError(gen.Error{Code: "BAD_REQUEST", Timestamp: time.Now(), Message: err.Error()})
+		} else {
+			reply = s.handle(ctx, command)
+		}
+		reply.Id = command.Id
+
+		// The commands aren't read, until the writer catches up.
+		select {
+		case <-ctx.Done():
+			return
+		case s.replies <- reply:
+		}
+	}
+}
+
+func (s *socket) handle(ctx context.Context, command gen.SocketCommand) gen.SocketMessage {
+	switch command.Type {
+	case gen.SocketCommandTypeSubscribe:
+		if command.LocationId == nil {
+			return socketFailure(&services.ValidationError{Field: "locationId", Message: "is required"})
+		}
+		location, err := s.stream.Subscribe(ctx, *command.LocationId)
+		if err != nil {
+			return socketFailure(err)
+		}
+		result := toLocation(location)
+		return gen.SocketMessage{Type: gen.SocketMessageTypeSubscribed, Location: &result}
+	case gen.SocketCommandTypeUnsubscribe:
+		if command.LocationId == nil {
+			return socketFailure(&services.ValidationError{Field: "locationId", Message: "is required"})
+		}
+		// Unsubscribing from the location, which isn't subscribed, is a no-op.
+		s.stream.Unsubscribe(*command.LocationId)
+		return gen.SocketMessage{Type: gen.SocketMessageTypeUnsubscribed, LocationId: command.LocationId}
+	case gen.SocketCommandTypeAuth:
+		var token string
+		if command.AccessToken != nil {
+			token = *command.AccessToken
+		}
+		user, expiresAt, err := s.api.userSvc.AuthenticateUntil(token)
+		if err != nil {
+			return socketFailure(err)
+		}
+		// The stream belongs to the user, so the token of another one can't continue it.
+		if user != s.user {
+			return socketFailure(services.ErrInvalidToken)
+		}
+
+		select {
+		case <-ctx.Done():
+		case s.expiries <- expiresAt:
+		}
+		return gen.SocketMessage{Type: gen.SocketMessageTypeAuthenticated, ExpiresAt: &expiresAt}
+	}
+
+	return socketError(gen.Error{
+		Code:      "BAD_REQUEST",
+		Timestamp: time.Now(),
+		Message:   "unknown command type " + string(command.Type),
+	})
+}
+
+// write sends the replies and the updates until the connection or the stream is closed, or the token expires.
+// It returns the reason to close the connection with, or nil if it's already closed.
+func (s *socket) write(ctx context.Context, expiresAt time.Time) error {
+	// The client sends auth with a fresh token, before the current one expires.
+	expiry := time.NewTimer(time.Until(expiresAt))
+	defer expiry.Stop()
+
+	for {
+		var message gen.SocketMessage
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.stream.Done():
+			return s.stream.Err()
+		case <-expiry.C:
+			return services.ErrInvalidToken
+		case at := <-s.expiries:
+			expiry.Reset(time.Until(at))
+			continue
+		case message = <-s.replies:
+		case update := <-s.stream.Updates():
+			message = s.toMessage(update)
+		}
+
+		if err := s.send(ctx, message); err != nil {
+			return nil
+		}
+	}
+}
+
+func (s *socket) toMessage(update services.StreamUpdate) gen.SocketMessage {
+	location := toLocation(update.Location)
+	message := gen.SocketMessage{Location: &location}
+	switch update.Kind {
+	case models.EventWeather:
+		weather := toCurrentWeather(update.Weather, s.preferences)
+		message.Type = gen.SocketMessageTypeWeather
+		message.Weather = &weather
+	case models.EventAlert:
+		alert := toAlert(update.Alert)
+		message.Type = gen.SocketMessageTypeAlert
+		message.Alert = &alert
+	}
+	return message
+}
+
+// ping pings the client every socketPingInterval and cancels the context, if it doesn't respond.
+func (s *socket) ping(ctx context.Context, cancel context.CancelFunc) {
+	ticker := time.NewTicker(socketPingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		pingCtx, pingCancel := context.WithTimeout(ctx, socketWriteTimeout)
+		err := s.conn.Ping(pingCtx)
+		pingCancel()
+		if err != nil {
+			cancel()
+			return
+		}
+	}
+}
+
+// close tells the client why the connection is closed, so it can decide whether to reconnect, and closes it.
+func (s *socket) close(err error) {
+	_, body := translateError(err)
+	if s.send(context.Background(), gen.SocketMessage{Type: gen.SocketMessageTypeClose, Error: &body}) != nil {
+		return
+	}
+
+	status := websocket.StatusPolicyViolation
+	switch {
+	case errors.Is(err, services.ErrStreamClosed):
+		status = websocket.StatusGoingAway
+	case errors.Is(err, services.ErrSlowConsumer):
+		status = websocket.StatusTryAgainLater
+	}
+	_ = s.conn.Close(status, body.Code)
+}
+
+// send writes the message, the connection is closed if it can't be written in socketWriteTimeout.
+func (s *socket) send(ctx context.Context, message gen.SocketMessage) error {
+	ctx, cancel := context.WithTimeout(ctx, socketWriteTimeout)
+	defer cancel()
+	return wsjson.Write(ctx, s.conn, message)
+}
+
+// socketFailure replies to the failed command with the error, like it would be reported by the handlers.
+func socketFailure(err error) gen.SocketMessage {
+	_, body := translateError(err)
+	return socketError(body)
+}
+
+func socketError(body gen.Error) gen.SocketMessage {
+	return gen.SocketMessage{Type: gen.SocketMessageTypeError, Error: &body}
+}
@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/maxdikun/weatherapp/internal/handlers/gen"
+	"github.com/maxdikun/weatherapp/internal/models"
+	"github.com/maxdikun/weatherapp/internal/services"
+)
+
+// streamHeartbeat is the interval of the comments, which keep idle streams from being closed by the proxies.
+const streamHeartbeat = 15 * time.Second
+
+// WeatherStream serves the WeatherStream operation as Server-Sent Events. It is excluded from
+// the generated server, because the strict handlers can't flush the response while it's written.
+func (api *ApiHandler) WeatherStream(w http.ResponseWriter, r *http.Request) {
+	// The operation is protected by bearerAuth, so the errors are reported like in the generated handlers.
+	r = r.WithContext(context.WithValue(r.Context(), gen.BearerAuthScopes, []string{}))
+
+	user, expiresAt, err := api.userSvc.AuthenticateUntil(accessTokenFromRequest(r))
+	if err != nil {
+		responseErrorHandler(w, r, err)
+		return
+	}
+	ctx := context.WithValue(r.Context(), userKey{}, user)
+
+	query := r.URL.Query()
+	preferences, err := api.weatherPreferences(
+		ctx,
+		optionalQuery[gen.Units](query, "units"),
+		optionalQuery[gen.WindSpeedUnit](query, "windSpeedUnit"),
+	)
+	if err != nil {
+		responseErrorHandler(w, r, err)
+		return
+	}
+
+	stream, err := api.streamSvc.Open(ctx, user)
+	if err != nil {
+		responseErrorHandler(w, r, err)
+		return
+	}
+	defer stream.Close()
+
+	if err := stream.SubscribeAll(ctx); err != nil {
+		responseErrorHandler(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	// Disables buffering of the response by nginx.
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	rc := http.NewResponseController(w)
+	// The stream outlives the write timeout of the server.
+	_ = rc.SetWriteDeadline(time.Time{})
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+	// The client reconnects with a fresh token, when the current one expires.
+	expiry := time.NewTimer(time.Until(expiresAt))
+	defer expiry.Stop()
+
+	for {
+		if err := rc.Flush(); err != nil {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-stream.Done():
+			writeCloseEvent(w, rc, stream.Err())
+			return
+		case <-expiry.C:
+			writeCloseEvent(w, rc, services.ErrInvalidToken)
+			return
+		case <-heartbeat.C:
+			_, err = io.WriteString(w, ": ping\n\n")
+		case update := <-stream.Updates():
+			err = writeStreamUpdate(w, update, preferences)
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func writeStreamUpdate(w io.Writer, update services.StreamUpdate, preferences models.Preferences) error {
+	switch update.Kind {
+	case models.EventWeather:
+		return writeEvent(w, "weather", gen.StreamWeather{
+			Location: toLocation(update.Location),
+			Weather:  toCurrentWeather(update.Weather, preferences),
+		})
+	case models.EventAlert:
+		return writeEvent(w, "alert", gen.StreamAlert{
+			Location: toLocation(update.Location),
+			Alert:    toAlert(update.Alert),
+		})
+	}
+	return nil
+}
+
+// writeCloseEvent tells the client why the stream is closed, so it can decide whether to reconnect.
+func writeCloseEvent(w io.Writer, rc *http.ResponseController, err error) {
+	_, body := translateError(err)
+	if writeEvent(w, "close", body) == nil {
+		_ = rc.Flush()
+	}
+}
+
+func writeEvent(w io.Writer, event string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, payload)
+	return err
+}
+
+// accessTokenFromRequest returns the bearer token, or the accessToken parameter for the clients,
+// which can't set the headers, e.g. EventSource in browsers.
+func accessTokenFromRequest(r *http.Request) string {
+	if token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+		return token
+	}
+	return r.URL.Query().Get("accessToken")
+}
+
+// optionalQuery returns the query parameter, or nil if it's absent.
+func optionalQuery[T ~string](query url.Values, name string) *T {
+	if !query.Has(name) {
+		return nil
+	}
+	v := T(query.Get(name))
+	return &v
+}
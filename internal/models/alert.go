@@ -0,0 +1,81 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AlertMetric is a value of the hourly forecast, which is compared with the threshold.
+type AlertMetric string
+
+const (
+	AlertTemperature              AlertMetric = "temperature"
+	AlertFeelsLike                AlertMetric = "feelsLike"
+	AlertHumidity                 AlertMetric = "humidity"
+	AlertWindSpeed                AlertMetric = "windSpeed"
+	AlertWindGust                 AlertMetric = "windGust"
+	AlertPrecipitation            AlertMetric = "precipitation"
+	AlertPrecipitationProbability AlertMetric = "precipitationProbability"
+)
+
+type AlertOperator string
+
+const (
+	AlertAbove AlertOperator = "above"
+	AlertBelow AlertOperator = "below"
+)
+
+// AlertPeriod is the part of the forecast, which is checked.
+// Days are in the time zone of the user.
+type AlertPeriod string
+
+const (
+	AlertNext24Hours AlertPeriod = "next24h"
+	AlertToday       AlertPeriod = "today"
+	AlertTomorrow    AlertPeriod = "tomorrow"
+)
+
+// AlertSubscription is a rule, which triggers an alert when the forecast for the location
+// has the metric above or below the threshold during the period.
+// Thresholds are in the units of CurrentWeather and HourlyForecast.
+type AlertSubscription struct {
+	Id        uuid.UUID
+	User      uuid.UUID
+	Location  uuid.UUID
+	Metric    AlertMetric
+	Operator  AlertOperator
+	Threshold float64
+	Period    AlertPeriod
+	CreatedAt time.Time
+}
+
+// AlertTarget is a subscription with the data needed to evaluate it.
+type AlertTarget struct {
+	Subscription AlertSubscription
+	LocationName string
+	Coordinates  Coordinates
+	TimeZone     string
+}
+
+// Location returns the time zone of the user, or UTC if it is unknown.
+func (t AlertTarget) Location() *time.Location {
+	return loadLocation(t.TimeZone)
+}
+
+// Alert is a triggered subscription. A subscription triggers at most once per Day,
+// which is the date of ForecastTime in the time zone of the user at midnight UTC.
+type Alert struct {
+	Id           uuid.UUID
+	Subscription uuid.UUID
+	User         uuid.UUID
+	Location     uuid.UUID
+	Metric       AlertMetric
+	Operator     AlertOperator
+	Threshold    float64
+	// Value is the forecasted value at ForecastTime, the first hour when the rule matched.
+	Value        float64
+	ForecastTime time.Time
+	Day          time.Time
+	TriggeredAt  time.Time
+}
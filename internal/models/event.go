@@ -0,0 +1,19 @@
+package models
+
+type EventKind string
+
+const (
+	EventWeather EventKind = "weather"
+	EventAlert   EventKind = "alert"
+)
+
+// Event is published to every replica of the app, so they can push it to the connected clients.
+type Event struct {
+	Kind EventKind
+	// Coordinates and Weather are set for EventWeather. Coordinates are the ones requested from the provider,
+	// they can differ from the coordinates of the weather station.
+	Coordinates Coordinates
+	Weather     *CurrentWeather
+	// Alert is set for EventAlert.
+	Alert *Alert
+}
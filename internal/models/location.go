@@ -0,0 +1,17 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Location is a place saved by the user, locations of the user are ordered by Position.
+type Location struct {
+	Id          uuid.UUID
+	User        uuid.UUID
+	Name        string
+	Coordinates Coordinates
+	Position    int
+	CreatedAt   time.Time
+}
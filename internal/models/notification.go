@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NotificationKind string
+
+const (
+	NotificationAlert           NotificationKind = "alert"
+	NotificationNewLogin        NotificationKind = "new_login"
+	NotificationPasswordChanged NotificationKind = "password_changed"
+	// NotificationEmailVerification is sent only to the email, which is being verified.
+	NotificationEmailVerification NotificationKind = "email_verification"
+)
+
+// Notification is a message to the user, which is stored in the outbox until it is delivered
+// to every channel configured by the user.
+type Notification struct {
+	Id      uuid.UUID
+	User    uuid.UUID
+	Kind    NotificationKind
+	Title   string
+	Message string
+	// Data contains the details of the event, it is sent as is to machine readable channels.
+	Data      map[string]any
+	CreatedAt time.Time
+
+	// Attempts is the number of delivery attempts including the current one.
+	Attempts int
+	// Delivered contains names of the channels, which have already received the notification.
+	Delivered []string
+}
+
+// NotificationSettings are the addresses of the user in the notification channels, empty ones are disabled.
+type NotificationSettings struct {
+	User  uuid.UUID
+	Email string
+	// EmailVerified is set, when the user confirms the code sent to Email. Until then only the code
+	// is sent to it, so the notifications can't be sent to an address of someone else.
+	EmailVerified bool
+	// EmailVerificationHash is the hash of the pending code, which is valid until EmailVerificationExpiresAt.
+	EmailVerificationHash      string
+	EmailVerificationExpiresAt time.Time
+	// WebhookSecret signs the requests to WebhookURL, so the receiver can verify them.
+	WebhookURL    string
+	WebhookSecret string
+}
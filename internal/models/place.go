@@ -0,0 +1,12 @@
+package models
+
+// Place is a result of geocoding.
+// Id is assigned by the geocoder and can be used to look the place up again.
+type Place struct {
+	Id          string
+	Name        string
+	DisplayName string
+	Country     string
+	CountryCode string
+	Coordinates Coordinates
+}
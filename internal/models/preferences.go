@@ -0,0 +1,72 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UnitSystem selects units of temperatures, pressure and precipitation.
+type UnitSystem string
+
+const (
+	UnitsMetric   UnitSystem = "metric"   // °C, hPa, mm
+	UnitsImperial UnitSystem = "imperial" // °F, inHg, in
+	UnitsSI       UnitSystem = "si"       // K, Pa, mm
+)
+
+type WindSpeedUnit string
+
+const (
+	WindSpeedMetersPerSecond   WindSpeedUnit = "mps"
+	WindSpeedKilometersPerHour WindSpeedUnit = "kmh"
+	WindSpeedMilesPerHour      WindSpeedUnit = "mph"
+	WindSpeedKnots             WindSpeedUnit = "knots"
+)
+
+type ClockFormat string
+
+const (
+	Clock24h ClockFormat = "24h"
+	Clock12h ClockFormat = "12h"
+)
+
+// Units are the units of the weather data shown to the user.
+type Units struct {
+	System    UnitSystem
+	WindSpeed WindSpeedUnit
+}
+
+// Preferences are the display settings of the user.
+type Preferences struct {
+	User     uuid.UUID
+	Units    Units
+	TimeZone string // IANA name of the time zone
+	Language string // BCP 47 language tag
+	Clock    ClockFormat
+}
+
+// DefaultPreferences are used until the user changes them,
+// they match the units of the providers, so the weather data is returned as is.
+func DefaultPreferences(user uuid.UUID) Preferences {
+	return Preferences{
+		User:     user,
+		Units:    Units{System: UnitsMetric, WindSpeed: WindSpeedMetersPerSecond},
+		TimeZone: "UTC",
+		Language: "en",
+		Clock:    Clock24h,
+	}
+}
+
+// Location returns the time zone of the preferences, or UTC if it is unknown.
+func (p Preferences) Location() *time.Location {
+	return loadLocation(p.TimeZone)
+}
+
+func loadLocation(timeZone string) *time.Location {
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
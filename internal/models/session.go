@@ -13,4 +13,8 @@ type Session struct {
 	CreatedAt   time.Time
 	RefreshedAt time.Time
 	ExpiresAt   time.Time
+
+	// Device metadata of the client, which was last using the session.
+	UserAgent string
+	IP        string
 }
@@ -0,0 +1,100 @@
+package models
+
+import (
+	"math"
+	"time"
+)
+
+type Coordinates struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Round returns the coordinates rounded to the precision (number of decimal places).
+func (c Coordinates) Round(precision int) Coordinates {
+	return Coordinates{
+		Latitude:  roundTo(c.Latitude, precision),
+		Longitude: roundTo(c.Longitude, precision),
+	}
+}
+
+func roundTo(v float64, precision int) float64 {
+	scale := math.Pow10(precision)
+	v = math.Round(v*scale) / scale
+	if v == 0 {
+		// Negative zero would be formatted as a separate "-0.00".
+		return 0
+	}
+	return v
+}
+
+// WeatherCondition is a provider independent description of the weather.
+type WeatherCondition string
+
+const (
+	ConditionUnknown      WeatherCondition = "unknown"
+	ConditionClear        WeatherCondition = "clear"
+	ConditionPartlyCloudy WeatherCondition = "partly_cloudy"
+	ConditionCloudy       WeatherCondition = "cloudy"
+	ConditionFog          WeatherCondition = "fog"
+	ConditionDrizzle      WeatherCondition = "drizzle"
+	ConditionRain         WeatherCondition = "rain"
+	ConditionSnow         WeatherCondition = "snow"
+	ConditionThunderstorm WeatherCondition = "thunderstorm"
+)
+
+// CurrentWeather is an observation of the weather.
+// Temperatures are in °C, speeds in m/s, pressure in hPa at the sea level, humidity in percents.
+type CurrentWeather struct {
+	Coordinates   Coordinates
+	Temperature   float64
+	FeelsLike     float64
+	Humidity      float64
+	Pressure      float64
+	WindSpeed     float64
+	WindGust      float64
+	WindDirection float64 // in degrees
+	Condition     WeatherCondition
+	Description   string
+	ObservedAt    time.Time
+	// Provider is the name of the provider, which returned the data.
+	Provider string
+}
+
+// HourlyForecast is a forecast for one hour, starting at Time.
+// Units are the same as in CurrentWeather, precipitation is in mm and its probability is in percents.
+type HourlyForecast struct {
+	Time                     time.Time
+	Temperature              float64
+	FeelsLike                float64
+	Humidity                 float64
+	Pressure                 float64
+	WindSpeed                float64
+	WindGust                 float64
+	WindDirection            float64
+	PrecipitationProbability float64
+	Precipitation            float64
+	Condition                WeatherCondition
+	Description              string
+	Provider                 string
+}
+
+// DailyForecast is a forecast for one day, Date is the start of the day in UTC.
+// Units are the same as in HourlyForecast.
+type DailyForecast struct {
+	Date                     time.Time
+	TemperatureMin           float64
+	TemperatureMax           float64
+	Humidity                 float64
+	Pressure                 float64
+	WindSpeed                float64
+	WindGust                 float64
+	WindDirection            float64
+	PrecipitationProbability float64
+	Precipitation            float64
+	Condition                WeatherCondition
+	Description              string
+	Sunrise                  time.Time
+	Sunset                   time.Time
+	Provider                 string
+}
@@ -0,0 +1,17 @@
+package notifications
+
+import (
+	"context"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+)
+
+// Channel delivers notifications to the users.
+type Channel interface {
+	// Name identifies the channel in the outbox, so it should never change.
+	Name() string
+	// Accepts reports whether the notification should be sent to the address of the user in the channel.
+	Accepts(settings models.NotificationSettings, notification models.Notification) bool
+	// Send delivers the notification to the address from the settings.
+	Send(ctx context.Context, settings models.NotificationSettings, notification models.Notification) error
+}
@@ -0,0 +1,150 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+	"github.com/maxdikun/weatherapp/internal/notifications"
+)
+
+// Channel sends notifications as plain text emails through an SMTP server.
+// STARTTLS is used when the server supports it, so a local mail sink works without TLS.
+type Channel struct {
+	addr    string
+	host    string
+	auth    smtp.Auth
+	from    mail.Address
+	timeout time.Duration
+}
+
+var _ notifications.Channel = (*Channel)(nil)
+
+// Name implements notifications.Channel.
+func (c *Channel) Name() string {
+	return "email"
+}
+
+// Accepts implements notifications.Channel. Only the verification code is sent to the unverified email,
+// and only when the code was sent to the current one.
+func (c *Channel) Accepts(settings models.NotificationSettings, notification models.Notification) bool {
+	if settings.Email == "" {
+		return false
+	}
+	if notification.Kind == models.NotificationEmailVerification {
+		email, _ := notification.Data["email"].(string)
+		return !settings.EmailVerified && email == settings.Email
+	}
+	return settings.EmailVerified
+}
+
+// Send implements notifications.Channel.
+func (c *Channel) Send(ctx context.Context, settings models.NotificationSettings, notification models.Notification) error {
+	if err := c.send(ctx, settings.Email, c.message(settings.Email, notification)); err != nil {
+		return fmt.Errorf("email.Channel.Send: %w", err)
+	}
+	return nil
+}
+
+func (c *Channel) send(ctx context.Context, to string, message []byte) error {
+	dialer := &net.Dialer{Timeout: c.timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", c.addr)
+	if err != nil {
+		return err
+	}
+	// The SMTP client doesn't support contexts, so the connection is closed when the context is done.
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+	if err := conn.SetDeadline(time.Now().Add(c.timeout)); err != nil {
+		conn.Close()
+		return err
+	}
+
+	client, err := smtp.NewClient(conn, c.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: c.host}); err != nil {
+			return err
+		}
+	}
+	if c.auth != nil {
+		if err := client.Auth(c.auth); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(c.from.Address); err != nil {
+		return err
+	}
+	if err := client.Rcpt(to); err != nil {
+		return err
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(message); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+func (c *Channel) message(to string, notification models.Notification) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", c.from.String())
+	fmt.Fprintf(&buf, "To: %s\r\n", (&mail.Address{Address: to}).String())
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", notification.Title))
+	fmt.Fprintf(&buf, "Date: %s\r\n", notification.CreatedAt.Format(time.RFC1123Z))
+	fmt.Fprintf(&buf, "Message-ID: <%s@%s>\r\n", notification.Id, c.host)
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n")
+	buf.WriteString("\r\n")
+
+	body := quotedprintable.NewWriter(&buf)
+	_, _ = body.Write([]byte(notification.Message))
+	_ = body.Close()
+
+	return buf.Bytes()
+}
+
+// NewChannel creates the channel, which sends emails from the address through the server at host and port.
+// Authentication is skipped if username is empty.
+func NewChannel(host string, port int, username string, password string, from string, timeout time.Duration) (*Channel, error) {
+	address, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender address: %w", err)
+	}
+
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return &Channel{
+		addr:    net.JoinHostPort(host, strconv.Itoa(port)),
+		host:    host,
+		auth:    auth,
+		from:    *address,
+		timeout: timeout,
+	}, nil
+}
@@ -0,0 +1,45 @@
+package email
+
+import (
+	"testing"
+	"time"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+)
+
+func TestChannelAccepts(t *testing.T) {
+	channel, err := NewChannel("localhost", 25, "", "", "WeatherApp <noreply@weatherapp.local>", time.Second)
+	if err != nil {
+		t.Fatalf("NewChannel() error = %v", err)
+	}
+
+	alert := models.Notification{Kind: models.NotificationAlert}
+	verification := func(email string) models.Notification {
+		return models.Notification{
+			Kind: models.NotificationEmailVerification,
+			Data: map[string]any{"email": email},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		settings     models.NotificationSettings
+		notification models.Notification
+		want         bool
+	}{
+		{"no email", models.NotificationSettings{}, alert, false},
+		{"unverified email", models.NotificationSettings{Email: "a@example.com"}, alert, false},
+		{"verified email", models.NotificationSettings{Email: "a@example.com", EmailVerified: true}, alert, true},
+		{"code to unverified email", models.NotificationSettings{Email: "a@example.com"}, verification("a@example.com"), true},
+		{"code to replaced email", models.NotificationSettings{Email: "b@example.com"}, verification("a@example.com"), false},
+		{"code to verified email", models.NotificationSettings{Email: "a@example.com", EmailVerified: true}, verification("a@example.com"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := channel.Accepts(tt.settings, tt.notification); got != tt.want {
+				t.Errorf("Accepts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+	"github.com/maxdikun/weatherapp/internal/notifications"
+)
+
+const (
+	IdHeader        = "X-Webhook-Id"
+	TimestampHeader = "X-Webhook-Timestamp"
+	// SignatureHeader contains "sha256=" followed by hex encoded HMAC-SHA256 of
+	// the timestamp from TimestampHeader, a dot and the body, keyed with the secret of the user.
+	SignatureHeader = "X-Webhook-Signature"
+)
+
+// StatusError is returned when the receiver responds with a status other than 2xx.
+type StatusError struct {
+	StatusCode int
+	Message    string
+}
+
+var _ error = (*StatusError)(nil)
+
+// Error implements error.
+func (err *StatusError) Error() string {
+	return fmt.Sprintf("webhook responded with status %d: %s", err.StatusCode, err.Message)
+}
+
+// retryable reports whether the receiver may accept the request later.
+func (err *StatusError) retryable() bool {
+	return err.StatusCode >= 500 || err.StatusCode == http.StatusTooManyRequests
+}
+
+// Channel posts notifications as JSON to the webhooks of the users.
+// Failed requests are retried with exponential backoff, unless the receiver rejects them with 4xx status.
+type Channel struct {
+	httpClient *http.Client
+	userAgent  string
+	retries    int
+	backoff    time.Duration
+}
+
+var _ notifications.Channel = (*Channel)(nil)
+
+type payload struct {
+	Id        uuid.UUID      `json:"id"`
+	Kind      string         `json:"kind"`
+	Title     string         `json:"title"`
+	Message   string         `json:"message"`
+	Data      map[string]any `json:"data"`
+	CreatedAt time.Time      `json:"createdAt"`
+}
+
+// Name implements notifications.Channel.
+func (c *Channel) Name() string {
+	return "webhook"
+}
+
+// Accepts implements notifications.Channel. The email verification codes are sent only to the email.
+func (c *Channel) Accepts(settings models.NotificationSettings, notification models.Notification) bool {
+	return settings.WebhookURL != "" && settings.WebhookSecret != "" &&
+		notification.Kind != models.NotificationEmailVerification
+}
+
+// Send implements notifications.Channel.
+func (c *Channel) Send(ctx context.Context, settings models.NotificationSettings, notification models.Notification) error {
+	body, err := json.Marshal(payload{
+		Id:        notification.Id,
+		Kind:      string(notification.Kind),
+		Title:     notification.Title,
+		Message:   notification.Message,
+		Data:      notification.Data,
+		CreatedAt: notification.CreatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("webhook.Channel.Send: %w", err)
+	}
+
+	for attempt := 0; ; attempt++ {
+		err = c.post(ctx, settings, notification.Id, body)
+
+		var statusErr *StatusError
+		if err == nil || (errors.As(err, &statusErr) && !statusErr.retryable()) || attempt >= c.retries {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("webhook.Channel.Send: %w", ctx.Err())
+		case <-time.After(c.backoff << attempt):
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("webhook.Channel.Send: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Channel) post(ctx context.Context, settings models.NotificationSettings, id uuid.UUID, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, settings.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	// The timestamp is signed, so receivers can reject replayed requests.
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+	req.Header.Set(IdHeader, id.String())
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, "sha256="+Sign(settings.WebhookSecret, timestamp, body))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return &StatusError{
+			StatusCode: resp.StatusCode,
+			Message:    strings.TrimSpace(string(message)),
+		}
+	}
+
+	// The body is drained, so the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+	return nil
+}
+
+// Sign returns hex encoded HMAC-SHA256 of the timestamp and the body, as in SignatureHeader.
+func Sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewChannel creates the channel, which retries failed requests at most retries times,
+// waiting backoff before the first retry and twice longer before every next one.
+func NewChannel(httpClient *http.Client, userAgent string, retries int, backoff time.Duration) *Channel {
+	return &Channel{
+		httpClient: httpClient,
+		userAgent:  userAgent,
+		retries:    retries,
+		backoff:    backoff,
+	}
+}
@@ -0,0 +1,139 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+)
+
+func TestSign(t *testing.T) {
+	got := Sign("whsec_test", "1700000000", []byte(`{"id":1}`))
+
+	// HMAC-SHA256 of `1700000000.{"id":1}` keyed with "whsec_test".
+	want := "2f441ba4b3b2d50d28a9ab9d9fd8880376ecd1eb5d0435401553f5d8d0a5dcf8"
+	if got != want {
+		t.Errorf("Sign() = %s, want %s", got, want)
+	}
+
+	if Sign("whsec_other", "1700000000", []byte(`{"id":1}`)) == want {
+		t.Errorf("Sign() doesn't depend on the secret")
+	}
+	if Sign("whsec_test", "1700000001", []byte(`{"id":1}`)) == want {
+		t.Errorf("Sign() doesn't depend on the timestamp")
+	}
+}
+
+func testNotification() models.Notification {
+	return models.Notification{
+		Id:        uuid.New(),
+		Kind:      models.NotificationNewLogin,
+		Title:     "New login to your account",
+		Message:   "Your account was signed in.",
+		Data:      map[string]any{"ip": "192.0.2.1"},
+		CreatedAt: time.Now(),
+	}
+}
+
+func TestChannelSend(t *testing.T) {
+	notification := testNotification()
+	secret := "whsec_test"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		if r.Header.Get(IdHeader) != notification.Id.String() {
+			t.Errorf("%s = %q, want %q", IdHeader, r.Header.Get(IdHeader), notification.Id)
+		}
+		want := "sha256=" + Sign(secret, r.Header.Get(TimestampHeader), body)
+		if !hmac.Equal([]byte(r.Header.Get(SignatureHeader)), []byte(want)) {
+			t.Errorf("%s = %q, want %q", SignatureHeader, r.Header.Get(SignatureHeader), want)
+		}
+
+		var received payload
+		if err := json.Unmarshal(body, &received); err != nil {
+			t.Errorf("body is not JSON: %v", err)
+		}
+		if received.Id != notification.Id || received.Kind != string(notification.Kind) {
+			t.Errorf("payload = %+v, want the notification %v", received, notification.Id)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	channel := NewChannel(server.Client(), "test", 0, time.Millisecond)
+	settings := models.NotificationSettings{WebhookURL: server.URL, WebhookSecret: secret}
+	if err := channel.Send(context.Background(), settings, notification); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+}
+
+func TestChannelSendRetries(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []int
+		wantErr  bool
+		wantCall int32
+	}{
+		{"server error is retried", []int{500, 503, 200}, false, 3},
+		{"too many requests is retried", []int{429, 200}, false, 2},
+		{"client error is not retried", []int{400, 200}, true, 1},
+		{"retries are limited", []int{500, 500, 500, 500, 200}, true, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				call := calls.Add(1)
+				w.WriteHeader(tt.statuses[call-1])
+			}))
+			defer server.Close()
+
+			channel := NewChannel(server.Client(), "test", 2, time.Millisecond)
+			settings := models.NotificationSettings{WebhookURL: server.URL, WebhookSecret: "whsec_test"}
+			err := channel.Send(context.Background(), settings, testNotification())
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Send() error = %v, want error %v", err, tt.wantErr)
+			}
+			var statusErr *StatusError
+			if err != nil && !errors.As(err, &statusErr) {
+				t.Errorf("Send() error = %v, want *StatusError", err)
+			}
+			if calls.Load() != tt.wantCall {
+				t.Errorf("calls = %d, want %d", calls.Load(), tt.wantCall)
+			}
+		})
+	}
+}
+
+func TestChannelAccepts(t *testing.T) {
+	channel := NewChannel(http.DefaultClient, "test", 0, 0)
+	settings := models.NotificationSettings{WebhookURL: "https://example.com/hook", WebhookSecret: "whsec_test"}
+
+	if !channel.Accepts(settings, testNotification()) {
+		t.Errorf("Accepts() = false, want true for a configured webhook")
+	}
+
+	verification := testNotification()
+	verification.Kind = models.NotificationEmailVerification
+	if channel.Accepts(settings, verification) {
+		t.Errorf("Accepts() = true, want the email verification to be sent only to the email")
+	}
+
+	if channel.Accepts(models.NotificationSettings{}, testNotification()) {
+		t.Errorf("Accepts() = true, want false without a webhook")
+	}
+}
@@ -0,0 +1,65 @@
+package webhook
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// ErrForbiddenAddress is returned when a webhook resolves to an address outside of the public internet.
+var ErrForbiddenAddress = errors.New("webhook address is not public")
+
+// NewTransport creates the transport for the webhooks. URLs are provided by the users,
+// so unless allowPrivateNetworks is set, connections to loopback, private and link-local
+// addresses are refused, including the ones reached by redirects and DNS rebinding.
+func NewTransport(allowPrivateNetworks bool) *http.Transport {
+	dialer := &net.Dialer{
+		Timeout: 10 * time.Second,
+	}
+	if !allowPrivateNetworks {
+		dialer.Control = func(network string, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+			if !isPublic(addrPort.Addr().Unmap()) {
+				return ErrForbiddenAddress
+			}
+			return nil
+		}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// A proxy would be the only checked address, so the webhooks are always dialed directly.
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return transport
+}
+
+// reservedPrefixes are global unicast by netip, but aren't reachable from the public internet.
+var reservedPrefixes = []netip.Prefix{
+	// Carrier-grade NAT, which is used by some clouds and VPNs for the internal networks.
+	netip.MustParsePrefix("100.64.0.0/10"),
+	// IETF protocol assignments.
+	netip.MustParsePrefix("192.0.0.0/24"),
+	// Benchmarking networks.
+	netip.MustParsePrefix("198.18.0.0/15"),
+	// NAT64 translates these addresses to any IPv4 one, including the private addresses.
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("64:ff9b:1::/48"),
+}
+
+func isPublic(addr netip.Addr) bool {
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() || addr.IsLoopback() || addr.IsLinkLocalUnicast() {
+		return false
+	}
+	for _, prefix := range reservedPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,61 @@
+package broadcast
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+	"github.com/maxdikun/weatherapp/internal/providers"
+	"github.com/maxdikun/weatherapp/internal/repositories"
+)
+
+// Provider publishes the current weather returned by another provider as events.
+//
+// When it's wrapped by the cache, the events are published whenever the cached data is loaded or refreshed.
+// Forecasts are passed through as is.
+type Provider struct {
+	logger *slog.Logger
+
+	provider providers.WeatherProvider
+	events   repositories.EventRepository
+}
+
+var _ providers.WeatherProvider = (*Provider)(nil)
+
+// Current implements providers.WeatherProvider.
+func (p *Provider) Current(ctx context.Context, coordinates models.Coordinates) (models.CurrentWeather, error) {
+	weather, err := p.provider.Current(ctx, coordinates)
+	if err != nil {
+		return weather, err
+	}
+
+	// Failure is only logged, the clients receive the data with the next update.
+	err = p.events.Publish(ctx, models.Event{
+		Kind:        models.EventWeather,
+		Coordinates: coordinates,
+		Weather:     &weather,
+	})
+	if err != nil {
+		p.logger.Warn("Failed to publish weather update", "err", err)
+	}
+
+	return weather, nil
+}
+
+// HourlyForecast implements providers.WeatherProvider.
+func (p *Provider) HourlyForecast(ctx context.Context, coordinates models.Coordinates, hours int) ([]models.HourlyForecast, error) {
+	return p.provider.HourlyForecast(ctx, coordinates, hours)
+}
+
+// DailyForecast implements providers.WeatherProvider.
+func (p *Provider) DailyForecast(ctx context.Context, coordinates models.Coordinates, days int) ([]models.DailyForecast, error) {
+	return p.provider.DailyForecast(ctx, coordinates, days)
+}
+
+func NewProvider(logger *slog.Logger, provider providers.WeatherProvider, events repositories.EventRepository) *Provider {
+	return &Provider{
+		logger:   logger,
+		provider: provider,
+		events:   events,
+	}
+}
@@ -0,0 +1,118 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+	"github.com/maxdikun/weatherapp/internal/repositories"
+)
+
+// backend implements stale-while-revalidate caching shared by the decorators of the package.
+type backend struct {
+	logger *slog.Logger
+
+	storage        repositories.CacheRepository
+	stale          time.Duration
+	refreshTimeout time.Duration
+
+	group singleflight.Group
+}
+
+// fetch returns the cached value of the key, loading it on a miss.
+// Failures of the storage are only logged, the value is loaded from the provider then.
+func fetch[T any](ctx context.Context, b *backend, key string, ttl time.Duration, load func(context.Context) (T, error)) (T, error) {
+	entry, err := b.storage.Get(ctx, key)
+	if err == nil {
+		var value T
+		if err := json.Unmarshal(entry.Value, &value); err == nil {
+			if time.Now().After(entry.StaleAt) {
+				go refresh(ctx, b, key, ttl, load)
+			}
+			return value, nil
+		}
+		b.logger.Warn("Cached value is malformed", "key", key, "err", err)
+	} else {
+		var notFound *repositories.NotFoundError
+		if !errors.As(err, &notFound) {
+			b.logger.Warn("Cache is unavailable", "key", key, "err", err)
+		}
+	}
+
+	// The request is shared by the callers, so it shouldn't be cancelled when the first one goes away.
+	value, err, _ := b.group.Do(key, func() (any, error) {
+		return store(context.WithoutCancel(ctx), b, key, ttl, load)
+	})
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return value.(T), nil
+}
+
+// refresh reloads the stale value of the key, unless another replica is already doing it.
+func refresh[T any](ctx context.Context, b *backend, key string, ttl time.Duration, load func(context.Context) (T, error)) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), b.refreshTimeout)
+	defer cancel()
+
+	// The lock is not released after the refresh, so a failing provider is not retried
+	// more often than once per refreshTimeout.
+	locked, err := b.storage.TryLock(ctx, key, b.refreshTimeout)
+	if err != nil {
+		b.logger.Warn("Failed to lock cache entry", "key", key, "err", err)
+		return
+	}
+	if !locked {
+		return
+	}
+
+	_, err, _ = b.group.Do(key, func() (any, error) {
+		return store(ctx, b, key, ttl, load)
+	})
+	if err != nil {
+		b.logger.Warn("Failed to refresh cache entry", "key", key, "err", err)
+	}
+}
+
+// store loads the value from the provider and caches it.
+func store[T any](ctx context.Context, b *backend, key string, ttl time.Duration, load func(context.Context) (T, error)) (T, error) {
+	value, err := load(ctx)
+	if err != nil {
+		return value, err
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return value, err
+	}
+
+	entry := repositories.CacheEntry{Value: data, StaleAt: time.Now().Add(ttl)}
+	if err := b.storage.Set(ctx, key, entry, ttl+b.stale); err != nil {
+		b.logger.Warn("Failed to store cache entry", "key", key, "err", err)
+	}
+
+	return value, nil
+}
+
+func roundCoordinates(coordinates models.Coordinates, precision int) models.Coordinates {
+	return coordinates.Round(precision)
+}
+
+func formatCoordinates(coordinates models.Coordinates, precision int) string {
+	return fmt.Sprintf("%.*f:%.*f", precision, coordinates.Latitude, precision, coordinates.Longitude)
+}
+
+func newBackend(logger *slog.Logger, storage repositories.CacheRepository, stale time.Duration, refreshTimeout time.Duration) *backend {
+	return &backend{
+		logger:         logger,
+		storage:        storage,
+		stale:          stale,
+		refreshTimeout: refreshTimeout,
+	}
+}
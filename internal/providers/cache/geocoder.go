@@ -0,0 +1,83 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+	"github.com/maxdikun/weatherapp/internal/providers"
+	"github.com/maxdikun/weatherapp/internal/repositories"
+)
+
+// Geocoder caches the results of another geocoder for the ttl, in the same way as Provider.
+// Queries are compared case-insensitively, coordinates of reverse geocoding are rounded to the precision.
+type Geocoder struct {
+	backend *backend
+
+	geocoder  providers.Geocoder
+	precision int
+	ttl       time.Duration
+}
+
+var _ providers.Geocoder = (*Geocoder)(nil)
+
+// Search implements providers.Geocoder.
+func (g *Geocoder) Search(ctx context.Context, query string, limit int) ([]models.Place, error) {
+	normalized := strings.Join(strings.Fields(strings.ToLower(query)), " ")
+	key := fmt.Sprintf("geo:search:%d:%s", limit, normalized)
+
+	places, err := fetch(ctx, g.backend, key, g.ttl, func(ctx context.Context) ([]models.Place, error) {
+		return g.geocoder.Search(ctx, normalized, limit)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cache.Geocoder.Search: %w", err)
+	}
+	return places, nil
+}
+
+// Reverse implements providers.Geocoder.
+func (g *Geocoder) Reverse(ctx context.Context, coordinates models.Coordinates) (models.Place, error) {
+	coordinates = roundCoordinates(coordinates, g.precision)
+	key := fmt.Sprintf("geo:reverse:%s", formatCoordinates(coordinates, g.precision))
+
+	place, err := fetch(ctx, g.backend, key, g.ttl, func(ctx context.Context) (models.Place, error) {
+		return g.geocoder.Reverse(ctx, coordinates)
+	})
+	if err != nil {
+		return models.Place{}, fmt.Errorf("cache.Geocoder.Reverse: %w", err)
+	}
+	return place, nil
+}
+
+// Lookup implements providers.Geocoder.
+func (g *Geocoder) Lookup(ctx context.Context, id string) (models.Place, error) {
+	key := fmt.Sprintf("geo:place:%s", id)
+
+	place, err := fetch(ctx, g.backend, key, g.ttl, func(ctx context.Context) (models.Place, error) {
+		return g.geocoder.Lookup(ctx, id)
+	})
+	if err != nil {
+		return models.Place{}, fmt.Errorf("cache.Geocoder.Lookup: %w", err)
+	}
+	return place, nil
+}
+
+func NewGeocoder(
+	logger *slog.Logger,
+	geocoder providers.Geocoder,
+	storage repositories.CacheRepository,
+	precision int,
+	ttl time.Duration,
+	stale time.Duration,
+	refreshTimeout time.Duration,
+) *Geocoder {
+	return &Geocoder{
+		backend:   newBackend(logger, storage, stale, refreshTimeout),
+		geocoder:  geocoder,
+		precision: precision,
+		ttl:       ttl,
+	}
+}
@@ -0,0 +1,104 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+	"github.com/maxdikun/weatherapp/internal/providers"
+	"github.com/maxdikun/weatherapp/internal/repositories"
+)
+
+// TTL contains durations for which the data of each type is considered fresh.
+type TTL struct {
+	Current time.Duration
+	Hourly  time.Duration
+	Daily   time.Duration
+	// Stale is the duration for which the data is still served after it became stale,
+	// while it is refreshed in the background.
+	Stale time.Duration
+}
+
+// Provider caches the data of another provider.
+//
+// Coordinates are rounded to the precision (number of decimal places) before they are
+// passed to the provider, so close locations share the cache entry.
+// Stale entries are served as is and refreshed in the background by one replica at a time.
+// Concurrent misses of the same entry are collapsed into one request to the provider.
+type Provider struct {
+	backend *backend
+
+	provider  providers.WeatherProvider
+	precision int
+	ttl       TTL
+}
+
+var _ providers.WeatherProvider = (*Provider)(nil)
+
+// Current implements providers.WeatherProvider.
+func (p *Provider) Current(ctx context.Context, coordinates models.Coordinates) (models.CurrentWeather, error) {
+	coordinates = p.round(coordinates)
+	key := fmt.Sprintf("weather:current:%s", p.formatCoordinates(coordinates))
+
+	weather, err := fetch(ctx, p.backend, key, p.ttl.Current, func(ctx context.Context) (models.CurrentWeather, error) {
+		return p.provider.Current(ctx, coordinates)
+	})
+	if err != nil {
+		return models.CurrentWeather{}, fmt.Errorf("cache.Provider.Current: %w", err)
+	}
+	return weather, nil
+}
+
+// HourlyForecast implements providers.WeatherProvider.
+func (p *Provider) HourlyForecast(ctx context.Context, coordinates models.Coordinates, hours int) ([]models.HourlyForecast, error) {
+	coordinates = p.round(coordinates)
+	key := fmt.Sprintf("weather:hourly:%s:%d", p.formatCoordinates(coordinates), hours)
+
+	forecast, err := fetch(ctx, p.backend, key, p.ttl.Hourly, func(ctx context.Context) ([]models.HourlyForecast, error) {
+		return p.provider.HourlyForecast(ctx, coordinates, hours)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cache.Provider.HourlyForecast: %w", err)
+	}
+	return forecast, nil
+}
+
+// DailyForecast implements providers.WeatherProvider.
+func (p *Provider) DailyForecast(ctx context.Context, coordinates models.Coordinates, days int) ([]models.DailyForecast, error) {
+	coordinates = p.round(coordinates)
+	key := fmt.Sprintf("weather:daily:%s:%d", p.formatCoordinates(coordinates), days)
+
+	forecast, err := fetch(ctx, p.backend, key, p.ttl.Daily, func(ctx context.Context) ([]models.DailyForecast, error) {
+		return p.provider.DailyForecast(ctx, coordinates, days)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cache.Provider.DailyForecast: %w", err)
+	}
+	return forecast, nil
+}
+
+func (p *Provider) round(coordinates models.Coordinates) models.Coordinates {
+	return roundCoordinates(coordinates, p.precision)
+}
+
+func (p *Provider) formatCoordinates(coordinates models.Coordinates) string {
+	return formatCoordinates(coordinates, p.precision)
+}
+
+func NewProvider(
+	logger *slog.Logger,
+	provider providers.WeatherProvider,
+	storage repositories.CacheRepository,
+	precision int,
+	ttl TTL,
+	refreshTimeout time.Duration,
+) *Provider {
+	return &Provider{
+		backend:   newBackend(logger, storage, ttl.Stale, refreshTimeout),
+		provider:  provider,
+		precision: precision,
+		ttl:       ttl,
+	}
+}
@@ -0,0 +1,126 @@
+package composite
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+)
+
+// Blending takes non-numeric values (condition, description, wind direction, times)
+// from the result of the provider with the highest priority, numeric values are the medians
+// of every result. Forecast periods are matched by their time, periods missing from the
+// primary result are dropped. Wind gusts are omitted by some providers, when they are unknown,
+// so zero gusts are not counted.
+
+func blendCurrent(results []models.CurrentWeather) models.CurrentWeather {
+	weather := results[0]
+	weather.Temperature = median(results, func(w models.CurrentWeather) float64 { return w.Temperature })
+	weather.FeelsLike = median(results, func(w models.CurrentWeather) float64 { return w.FeelsLike })
+	weather.Humidity = median(results, func(w models.CurrentWeather) float64 { return w.Humidity })
+	weather.Pressure = median(results, func(w models.CurrentWeather) float64 { return w.Pressure })
+	weather.WindSpeed = median(results, func(w models.CurrentWeather) float64 { return w.WindSpeed })
+	weather.WindGust = medianKnown(results, func(w models.CurrentWeather) float64 { return w.WindGust })
+	weather.Provider = joinProviders(results, func(w models.CurrentWeather) string { return w.Provider })
+	return weather
+}
+
+func blendHourly(results [][]models.HourlyForecast) []models.HourlyForecast {
+	periods := matchPeriods(results, func(f models.HourlyForecast) time.Time { return f.Time })
+
+	forecast := make([]models.HourlyForecast, len(periods))
+	for i, p := range periods {
+		f := p[0]
+		f.Temperature = median(p, func(f models.HourlyForecast) float64 { return f.Temperature })
+		f.FeelsLike = median(p, func(f models.HourlyForecast) float64 { return f.FeelsLike })
+		f.Humidity = median(p, func(f models.HourlyForecast) float64 { return f.Humidity })
+		f.Pressure = median(p, func(f models.HourlyForecast) float64 { return f.Pressure })
+		f.WindSpeed = median(p, func(f models.HourlyForecast) float64 { return f.WindSpeed })
+		f.WindGust = medianKnown(p, func(f models.HourlyForecast) float64 { return f.WindGust })
+		f.PrecipitationProbability = median(p, func(f models.HourlyForecast) float64 { return f.PrecipitationProbability })
+		f.Precipitation = median(p, func(f models.HourlyForecast) float64 { return f.Precipitation })
+		f.Provider = joinProviders(p, func(f models.HourlyForecast) string { return f.Provider })
+		forecast[i] = f
+	}
+	return forecast
+}
+
+func blendDaily(results [][]models.DailyForecast) []models.DailyForecast {
+	periods := matchPeriods(results, func(f models.DailyForecast) time.Time { return f.Date })
+
+	forecast := make([]models.DailyForecast, len(periods))
+	for i, p := range periods {
+		f := p[0]
+		f.TemperatureMin = median(p, func(f models.DailyForecast) float64 { return f.TemperatureMin })
+		f.TemperatureMax = median(p, func(f models.DailyForecast) float64 { return f.TemperatureMax })
+		f.Humidity = median(p, func(f models.DailyForecast) float64 { return f.Humidity })
+		f.Pressure = median(p, func(f models.DailyForecast) float64 { return f.Pressure })
+		f.WindSpeed = median(p, func(f models.DailyForecast) float64 { return f.WindSpeed })
+		f.WindGust = medianKnown(p, func(f models.DailyForecast) float64 { return f.WindGust })
+		f.PrecipitationProbability = median(p, func(f models.DailyForecast) float64 { return f.PrecipitationProbability })
+		f.Precipitation = median(p, func(f models.DailyForecast) float64 { return f.Precipitation })
+		f.Provider = joinProviders(p, func(f models.DailyForecast) string { return f.Provider })
+		forecast[i] = f
+	}
+	return forecast
+}
+
+// matchPeriods groups the periods of the primary result with the periods of the other results
+// for the same time, the primary period is always the first one of the group.
+func matchPeriods[T any](results [][]T, key func(T) time.Time) [][]T {
+	groups := make([][]T, len(results[0]))
+	index := make(map[time.Time]int, len(results[0]))
+	for i, period := range results[0] {
+		groups[i] = []T{period}
+		index[key(period).UTC()] = i
+	}
+
+	for _, result := range results[1:] {
+		for _, period := range result {
+			if i, ok := index[key(period).UTC()]; ok {
+				groups[i] = append(groups[i], period)
+			}
+		}
+	}
+	return groups
+}
+
+func median[T any](items []T, value func(T) float64) float64 {
+	values := make([]float64, len(items))
+	for i, item := range items {
+		values[i] = value(item)
+	}
+	slices.Sort(values)
+
+	n := len(values)
+	if n%2 == 1 {
+		return values[n/2]
+	}
+	return (values[n/2-1] + values[n/2]) / 2
+}
+
+// medianKnown is median of the non-zero values, zero is returned if every value is zero.
+func medianKnown[T any](items []T, value func(T) float64) float64 {
+	known := make([]float64, 0, len(items))
+	for _, item := range items {
+		if v := value(item); v != 0 {
+			known = append(known, v)
+		}
+	}
+	if len(known) == 0 {
+		return 0
+	}
+	return median(known, func(v float64) float64 { return v })
+}
+
+// joinProviders returns the names of the providers of the items, e.g. "openweathermap+openmeteo".
+func joinProviders[T any](items []T, provider func(T) string) string {
+	var names []string
+	for _, item := range items {
+		if name := provider(item); !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, "+")
+}
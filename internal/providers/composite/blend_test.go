@@ -0,0 +1,68 @@
+package composite
+
+import (
+	"testing"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+)
+
+func TestMedian(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+		want   float64
+	}{
+		{"single", []float64{3}, 3},
+		{"odd", []float64{5, 1, 3}, 3},
+		{"even", []float64{4, 1, 3, 2}, 2.5},
+		{"negative", []float64{-10, -2, -4}, -4},
+		{"duplicates", []float64{2, 2, 7}, 2},
+		{"zeros", []float64{0, 0, 6}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := median(tt.values, func(v float64) float64 { return v }); got != tt.want {
+				t.Errorf("median(%v) = %v, want %v", tt.values, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMedianKnown(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+		want   float64
+	}{
+		{"all known", []float64{8, 12, 10}, 10},
+		{"unknown skipped", []float64{0, 12, 10}, 11},
+		{"single known", []float64{0, 9}, 9},
+		{"all unknown", []float64{0, 0}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := medianKnown(tt.values, func(v float64) float64 { return v }); got != tt.want {
+				t.Errorf("medianKnown(%v) = %v, want %v", tt.values, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBlendCurrentSkipsUnknownGusts(t *testing.T) {
+	weather := blendCurrent([]models.CurrentWeather{
+		{Temperature: 10, WindGust: 0, Provider: "openweathermap"},
+		{Temperature: 12, WindGust: 14, Provider: "openmeteo"},
+	})
+
+	if weather.WindGust != 14 {
+		t.Errorf("WindGust = %v, want 14", weather.WindGust)
+	}
+	if weather.Temperature != 11 {
+		t.Errorf("Temperature = %v, want 11", weather.Temperature)
+	}
+	if weather.Provider != "openweathermap+openmeteo" {
+		t.Errorf("Provider = %q, want %q", weather.Provider, "openweathermap+openmeteo")
+	}
+}
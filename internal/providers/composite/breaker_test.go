@@ -0,0 +1,112 @@
+package composite
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	type step struct {
+		// call is one of allow, success, failure, abort or expire.
+		call string
+		// allowed is the expected result of allow.
+		allowed bool
+	}
+
+	tests := []struct {
+		name      string
+		threshold int
+		cooldown  time.Duration
+		steps     []step
+	}{
+		{
+			name:      "disabled",
+			threshold: 0,
+			cooldown:  time.Hour,
+			steps: []step{
+				{"failure", false}, {"failure", false}, {"allow", true},
+			},
+		},
+		{
+			name:      "closed below threshold",
+			threshold: 3,
+			cooldown:  time.Hour,
+			steps: []step{
+				{"failure", false}, {"failure", false}, {"allow", true},
+			},
+		},
+		{
+			name:      "success resets failures",
+			threshold: 2,
+			cooldown:  time.Hour,
+			steps: []step{
+				{"failure", false}, {"success", false}, {"failure", false}, {"allow", true},
+			},
+		},
+		{
+			name:      "open during cooldown",
+			threshold: 2,
+			cooldown:  time.Hour,
+			steps: []step{
+				{"failure", false}, {"failure", false}, {"allow", false}, {"allow", false},
+			},
+		},
+		{
+			name:      "one trial after cooldown",
+			threshold: 2,
+			cooldown:  0,
+			steps: []step{
+				{"failure", false}, {"failure", false}, {"allow", true}, {"allow", false},
+			},
+		},
+		{
+			name:      "successful trial closes",
+			threshold: 2,
+			cooldown:  0,
+			steps: []step{
+				{"failure", false}, {"failure", false}, {"allow", true}, {"success", false},
+				{"allow", true}, {"allow", true},
+			},
+		},
+		{
+			name:      "failed trial opens again",
+			threshold: 2,
+			cooldown:  time.Hour,
+			steps: []step{
+				{"failure", false}, {"failure", false}, {"expire", false}, {"allow", true}, {"failure", false},
+				{"allow", false},
+			},
+		},
+		{
+			name:      "aborted trial allows another",
+			threshold: 2,
+			cooldown:  0,
+			steps: []step{
+				{"failure", false}, {"failure", false}, {"allow", true}, {"abort", false}, {"allow", true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &circuitBreaker{threshold: tt.threshold, cooldown: tt.cooldown}
+			for i, s := range tt.steps {
+				switch s.call {
+				case "allow":
+					if got := b.allow(); got != s.allowed {
+						t.Fatalf("step %d: allow() = %v, want %v", i, got, s.allowed)
+					}
+				case "success":
+					b.success()
+				case "failure":
+					b.failure()
+				case "abort":
+					b.abort()
+				case "expire":
+					// Ends the cooldown without waiting for it.
+					b.openUntil = time.Now()
+				}
+			}
+		})
+	}
+}
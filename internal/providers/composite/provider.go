@@ -0,0 +1,218 @@
+package composite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+	"github.com/maxdikun/weatherapp/internal/providers"
+)
+
+// ErrNoProviders is returned when the circuits of every provider are open.
+var ErrNoProviders = errors.New("no weather provider is available")
+
+// Source is a provider used by the composite one.
+type Source struct {
+	Name     string
+	Provider providers.WeatherProvider
+	// Timeout limits the duration of every call to the provider.
+	Timeout time.Duration
+}
+
+// Provider combines several providers.
+//
+// By default the providers are tried in the order of priority until one of them succeeds.
+// If blending is enabled, every available provider is called and numeric values of
+// their results are replaced with the medians.
+// A provider is skipped for the cooldown after failureThreshold consecutive failures.
+type Provider struct {
+	logger *slog.Logger
+
+	sources []source
+	blend   bool
+}
+
+type source struct {
+	Source
+	breaker *circuitBreaker
+}
+
+var _ providers.WeatherProvider = (*Provider)(nil)
+
+// Current implements providers.WeatherProvider.
+func (p *Provider) Current(ctx context.Context, coordinates models.Coordinates) (models.CurrentWeather, error) {
+	fetch := func(ctx context.Context, provider providers.WeatherProvider) (models.CurrentWeather, error) {
+		return provider.Current(ctx, coordinates)
+	}
+
+	if !p.blend {
+		weather, err := first(ctx, p, fetch)
+		if err != nil {
+			return models.CurrentWeather{}, fmt.Errorf("composite.Provider.Current: %w", err)
+		}
+		return weather, nil
+	}
+
+	results, err := all(ctx, p, fetch)
+	if err != nil {
+		return models.CurrentWeather{}, fmt.Errorf("composite.Provider.Current: %w", err)
+	}
+	return blendCurrent(results), nil
+}
+
+// HourlyForecast implements providers.WeatherProvider.
+func (p *Provider) HourlyForecast(ctx context.Context, coordinates models.Coordinates, hours int) ([]models.HourlyForecast, error) {
+	fetch := func(ctx context.Context, provider providers.WeatherProvider) ([]models.HourlyForecast, error) {
+		return provider.HourlyForecast(ctx, coordinates, hours)
+	}
+
+	if !p.blend {
+		forecast, err := first(ctx, p, fetch)
+		if err != nil {
+			return nil, fmt.Errorf("composite.Provider.HourlyForecast: %w", err)
+		}
+		return forecast, nil
+	}
+
+	results, err := all(ctx, p, fetch)
+	if err != nil {
+		return nil, fmt.Errorf("composite.Provider.HourlyForecast: %w", err)
+	}
+	return blendHourly(results), nil
+}
+
+// DailyForecast implements providers.WeatherProvider.
+func (p *Provider) DailyForecast(ctx context.Context, coordinates models.Coordinates, days int) ([]models.DailyForecast, error) {
+	fetch := func(ctx context.Context, provider providers.WeatherProvider) ([]models.DailyForecast, error) {
+		return provider.DailyForecast(ctx, coordinates, days)
+	}
+
+	if !p.blend {
+		forecast, err := first(ctx, p, fetch)
+		if err != nil {
+			return nil, fmt.Errorf("composite.Provider.DailyForecast: %w", err)
+		}
+		return forecast, nil
+	}
+
+	results, err := all(ctx, p, fetch)
+	if err != nil {
+		return nil, fmt.Errorf("composite.Provider.DailyForecast: %w", err)
+	}
+	return blendDaily(results), nil
+}
+
+// first returns the result of the first provider, which succeeds.
+func first[T any](ctx context.Context, p *Provider, fetch func(context.Context, providers.WeatherProvider) (T, error)) (T, error) {
+	var errs []error
+	for i := range p.sources {
+		s := &p.sources[i]
+		if !s.breaker.allow() {
+			continue
+		}
+
+		result, err := call(ctx, p, s, fetch)
+		if err == nil {
+			return result, nil
+		}
+		if ctx.Err() != nil {
+			return result, err
+		}
+		errs = append(errs, err)
+	}
+
+	var zero T
+	if len(errs) == 0 {
+		return zero, ErrNoProviders
+	}
+	return zero, errors.Join(errs...)
+}
+
+// all returns the results of every provider, which succeeded, in the order of priority.
+func all[T any](ctx context.Context, p *Provider, fetch func(context.Context, providers.WeatherProvider) (T, error)) ([]T, error) {
+	results := make([]T, len(p.sources))
+	errs := make([]error, len(p.sources))
+	called := make([]bool, len(p.sources))
+
+	var wg sync.WaitGroup
+	for i := range p.sources {
+		s := &p.sources[i]
+		if !s.breaker.allow() {
+			continue
+		}
+
+		called[i] = true
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], errs[i] = call(ctx, p, s, fetch)
+		}()
+	}
+	wg.Wait()
+
+	var succeeded []T
+	var failed []error
+	for i := range p.sources {
+		if !called[i] {
+			continue
+		}
+		if errs[i] != nil {
+			failed = append(failed, errs[i])
+			continue
+		}
+		succeeded = append(succeeded, results[i])
+	}
+
+	if len(succeeded) != 0 {
+		return succeeded, nil
+	}
+	if len(failed) == 0 {
+		return nil, ErrNoProviders
+	}
+	return nil, errors.Join(failed...)
+}
+
+// call calls the provider of the source within its timeout and reports the outcome to its breaker.
+func call[T any](ctx context.Context, p *Provider, s *source, fetch func(context.Context, providers.WeatherProvider) (T, error)) (T, error) {
+	callCtx, cancel := context.WithTimeout(ctx, s.Timeout)
+	defer cancel()
+
+	result, err := fetch(callCtx, s.Provider)
+	switch {
+	case err == nil:
+		s.breaker.success()
+	case ctx.Err() != nil:
+		s.breaker.abort()
+	default:
+		s.breaker.failure()
+		p.logger.Warn("Weather provider failed", "provider", s.Name, "err", err)
+	}
+	return result, err
+}
+
+// NewProvider creates the provider from the sources in the order of priority.
+// Zero failureThreshold disables the circuit breakers.
+func NewProvider(
+	logger *slog.Logger,
+	sources []Source,
+	failureThreshold int,
+	cooldown time.Duration,
+	blend bool,
+) *Provider {
+	p := &Provider{
+		logger:  logger,
+		sources: make([]source, len(sources)),
+		blend:   blend,
+	}
+	for i, s := range sources {
+		p.sources[i] = source{
+			Source:  s,
+			breaker: &circuitBreaker{threshold: failureThreshold, cooldown: cooldown},
+		}
+	}
+	return p
+}
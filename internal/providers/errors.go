@@ -0,0 +1,23 @@
+package providers
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNotFound is returned when the upstream API has no data for the request.
+var ErrNotFound = errors.New("not found")
+
+// UpstreamError is returned when the upstream API responds with an error.
+type UpstreamError struct {
+	Provider   string
+	StatusCode int
+	Message    string
+}
+
+var _ error = (*UpstreamError)(nil)
+
+// Error implements error.
+func (err *UpstreamError) Error() string {
+	return fmt.Sprintf("provider '%s' responded with status %d: %s", err.Provider, err.StatusCode, err.Message)
+}
@@ -0,0 +1,17 @@
+package providers
+
+import (
+	"context"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+)
+
+// Geocoder converts between names of places and their coordinates.
+type Geocoder interface {
+	// Search returns at most limit places matching the query, the best matches first.
+	Search(ctx context.Context, query string, limit int) ([]models.Place, error)
+	// Reverse returns the place at the coordinates or ErrNotFound.
+	Reverse(ctx context.Context, coordinates models.Coordinates) (models.Place, error)
+	// Lookup returns the place by its id or ErrNotFound.
+	Lookup(ctx context.Context, id string) (models.Place, error)
+}
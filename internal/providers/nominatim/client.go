@@ -0,0 +1,166 @@
+package nominatim
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+	"github.com/maxdikun/weatherapp/internal/providers"
+)
+
+const providerName = "nominatim"
+
+// placeIdPattern matches ids of places, which are OSM ids prefixed with the type of the object,
+// e.g. "N240109189" for a node or "R62422" for a relation.
+var placeIdPattern = regexp.MustCompile(`^[NWR][0-9]+$`)
+
+// Client is a client of the Nominatim API.
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+	userAgent  string
+}
+
+var _ providers.Geocoder = (*Client)(nil)
+
+// Search implements providers.Geocoder.
+func (c *Client) Search(ctx context.Context, query string, limit int) ([]models.Place, error) {
+	var res []place
+	params := url.Values{"q": {query}, "limit": {strconv.Itoa(limit)}}
+	if err := c.get(ctx, "/search", params, &res); err != nil {
+		return nil, fmt.Errorf("nominatim.Client.Search: %w", err)
+	}
+
+	return convertPlaces(res)
+}
+
+// Reverse implements providers.Geocoder.
+func (c *Client) Reverse(ctx context.Context, coordinates models.Coordinates) (models.Place, error) {
+	var res place
+	params := url.Values{
+		"lat": {strconv.FormatFloat(coordinates.Latitude, 'f', -1, 64)},
+		"lon": {strconv.FormatFloat(coordinates.Longitude, 'f', -1, 64)},
+	}
+	if err := c.get(ctx, "/reverse", params, &res); err != nil {
+		return models.Place{}, fmt.Errorf("nominatim.Client.Reverse: %w", err)
+	}
+
+	if res.Error != "" {
+		return models.Place{}, fmt.Errorf("nominatim.Client.Reverse: %w", providers.ErrNotFound)
+	}
+
+	result, err := convertPlace(res)
+	if err != nil {
+		return models.Place{}, fmt.Errorf("nominatim.Client.Reverse: %w", err)
+	}
+	return result, nil
+}
+
+// Lookup implements providers.Geocoder.
+func (c *Client) Lookup(ctx context.Context, id string) (models.Place, error) {
+	if !placeIdPattern.MatchString(id) {
+		return models.Place{}, fmt.Errorf("nominatim.Client.Lookup: %w", providers.ErrNotFound)
+	}
+
+	var res []place
+	if err := c.get(ctx, "/lookup", url.Values{"osm_ids": {id}}, &res); err != nil {
+		return models.Place{}, fmt.Errorf("nominatim.Client.Lookup: %w", err)
+	}
+
+	places, err := convertPlaces(res)
+	if err != nil {
+		return models.Place{}, fmt.Errorf("nominatim.Client.Lookup: %w", err)
+	}
+	if len(places) == 0 {
+		return models.Place{}, fmt.Errorf("nominatim.Client.Lookup: %w", providers.ErrNotFound)
+	}
+	return places[0], nil
+}
+
+func (c *Client) get(ctx context.Context, path string, params url.Values, dst any) error {
+	params.Set("format", "jsonv2")
+	params.Set("addressdetails", "1")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path+"?"+params.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	// Usage policy of the public instance requires an identifying user agent.
+	req.Header.Set("User-Agent", c.userAgent)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var res errorResponse
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		message := strings.TrimSpace(string(body))
+		if err := json.Unmarshal(body, &res); err == nil && res.Error.Message != "" {
+			message = res.Error.Message
+		}
+
+		return &providers.UpstreamError{
+			Provider:   providerName,
+			StatusCode: resp.StatusCode,
+			Message:    message,
+		}
+	}
+
+	return json.NewDecoder(resp.Body).Decode(dst)
+}
+
+func convertPlaces(places []place) ([]models.Place, error) {
+	result := make([]models.Place, len(places))
+	for i, p := range places {
+		converted, err := convertPlace(p)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = converted
+	}
+	return result, nil
+}
+
+func convertPlace(p place) (models.Place, error) {
+	lat, err := strconv.ParseFloat(p.Lat, 64)
+	if err != nil {
+		return models.Place{}, fmt.Errorf("invalid latitude: %w", err)
+	}
+	lon, err := strconv.ParseFloat(p.Lon, 64)
+	if err != nil {
+		return models.Place{}, fmt.Errorf("invalid longitude: %w", err)
+	}
+
+	name := p.Name
+	if name == "" {
+		name, _, _ = strings.Cut(p.DisplayName, ",")
+	}
+
+	return models.Place{
+		Id:          strings.ToUpper(p.OsmType[:min(1, len(p.OsmType))]) + strconv.FormatInt(p.OsmId, 10),
+		Name:        name,
+		DisplayName: p.DisplayName,
+		Country:     p.Address.Country,
+		CountryCode: strings.ToUpper(p.Address.CountryCode),
+		Coordinates: models.Coordinates{Latitude: lat, Longitude: lon},
+	}, nil
+}
+
+// NewClient creates the client of the API at baseURL, e.g. "https://nominatim.openstreetmap.org".
+func NewClient(httpClient *http.Client, baseURL string, userAgent string) *Client {
+	return &Client{
+		httpClient: httpClient,
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		userAgent:  userAgent,
+	}
+}
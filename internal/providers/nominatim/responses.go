@@ -0,0 +1,22 @@
+package nominatim
+
+type place struct {
+	OsmType     string `json:"osm_type"`
+	OsmId       int64  `json:"osm_id"`
+	Lat         string `json:"lat"`
+	Lon         string `json:"lon"`
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+	Address     struct {
+		Country     string `json:"country"`
+		CountryCode string `json:"country_code"`
+	} `json:"address"`
+	// Error is set instead of the other fields when nothing is found by reverse geocoding.
+	Error string `json:"error"`
+}
+
+type errorResponse struct {
+	Error struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
@@ -0,0 +1,236 @@
+package openmeteo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+	"github.com/maxdikun/weatherapp/internal/providers"
+)
+
+const providerName = "openmeteo"
+
+const (
+	currentVariables = "temperature_2m,apparent_temperature,relative_humidity_2m,pressure_msl," +
+		"wind_speed_10m,wind_gusts_10m,wind_direction_10m,weather_code"
+	hourlyVariables = "temperature_2m,apparent_temperature,relative_humidity_2m,pressure_msl," +
+		"wind_speed_10m,wind_gusts_10m,wind_direction_10m,precipitation_probability,precipitation,weather_code"
+	dailyVariables = "temperature_2m_min,temperature_2m_max,relative_humidity_2m_mean,pressure_msl_mean," +
+		"wind_speed_10m_max,wind_gusts_10m_max,wind_direction_10m_dominant,precipitation_probability_max," +
+		"precipitation_sum,weather_code,sunrise,sunset"
+)
+
+// Client is a client of the Open-Meteo forecast API.
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+	apiKey     string
+}
+
+var _ providers.WeatherProvider = (*Client)(nil)
+
+// Current implements providers.WeatherProvider.
+func (c *Client) Current(ctx context.Context, coordinates models.Coordinates) (models.CurrentWeather, error) {
+	var res currentResponse
+	params := url.Values{"current": {currentVariables}}
+	if err := c.get(ctx, coordinates, params, &res); err != nil {
+		return models.CurrentWeather{}, fmt.Errorf("openmeteo.Client.Current: %w", err)
+	}
+
+	condition, description := convertWeatherCode(&res.Current.WeatherCode)
+
+	return models.CurrentWeather{
+		Coordinates:   models.Coordinates{Latitude: res.Latitude, Longitude: res.Longitude},
+		Temperature:   res.Current.Temperature,
+		FeelsLike:     res.Current.ApparentTemperature,
+		Humidity:      res.Current.RelativeHumidity,
+		Pressure:      res.Current.PressureMSL,
+		WindSpeed:     res.Current.WindSpeed,
+		WindGust:      res.Current.WindGusts,
+		WindDirection: res.Current.WindDirection,
+		Condition:     condition,
+		Description:   description,
+		ObservedAt:    time.Unix(res.Current.Time, 0).UTC(),
+		Provider:      providerName,
+	}, nil
+}
+
+// HourlyForecast implements providers.WeatherProvider.
+func (c *Client) HourlyForecast(ctx context.Context, coordinates models.Coordinates, hours int) ([]models.HourlyForecast, error) {
+	var res hourlyResponse
+	params := url.Values{"hourly": {hourlyVariables}, "forecast_hours": {strconv.Itoa(hours)}}
+	if err := c.get(ctx, coordinates, params, &res); err != nil {
+		return nil, fmt.Errorf("openmeteo.Client.HourlyForecast: %w", err)
+	}
+
+	h := res.Hourly
+	result := make([]models.HourlyForecast, len(h.Time))
+	for i, t := range h.Time {
+		condition, description := convertWeatherCode(at(h.WeatherCode, i))
+
+		result[i] = models.HourlyForecast{
+			Time:                     time.Unix(t, 0).UTC(),
+			Temperature:              value(h.Temperature, i),
+			FeelsLike:                value(h.ApparentTemperature, i),
+			Humidity:                 value(h.RelativeHumidity, i),
+			Pressure:                 value(h.PressureMSL, i),
+			WindSpeed:                value(h.WindSpeed, i),
+			WindGust:                 value(h.WindGusts, i),
+			WindDirection:            value(h.WindDirection, i),
+			PrecipitationProbability: value(h.PrecipitationProbability, i),
+			Precipitation:            value(h.Precipitation, i),
+			Condition:                condition,
+			Description:              description,
+			Provider:                 providerName,
+		}
+	}
+
+	return result, nil
+}
+
+// DailyForecast implements providers.WeatherProvider.
+func (c *Client) DailyForecast(ctx context.Context, coordinates models.Coordinates, days int) ([]models.DailyForecast, error) {
+	var res dailyResponse
+	params := url.Values{"daily": {dailyVariables}, "forecast_days": {strconv.Itoa(days)}}
+	if err := c.get(ctx, coordinates, params, &res); err != nil {
+		return nil, fmt.Errorf("openmeteo.Client.DailyForecast: %w", err)
+	}
+
+	d := res.Daily
+	result := make([]models.DailyForecast, len(d.Time))
+	for i, t := range d.Time {
+		condition, description := convertWeatherCode(at(d.WeatherCode, i))
+
+		result[i] = models.DailyForecast{
+			Date:                     time.Unix(t, 0).UTC().Truncate(24 * time.Hour),
+			TemperatureMin:           value(d.TemperatureMin, i),
+			TemperatureMax:           value(d.TemperatureMax, i),
+			Humidity:                 value(d.RelativeHumidity, i),
+			Pressure:                 value(d.PressureMSL, i),
+			WindSpeed:                value(d.WindSpeed, i),
+			WindGust:                 value(d.WindGusts, i),
+			WindDirection:            value(d.WindDirection, i),
+			PrecipitationProbability: value(d.PrecipitationProbability, i),
+			Precipitation:            value(d.Precipitation, i),
+			Condition:                condition,
+			Description:              description,
+			Sunrise:                  time.Unix(value(d.Sunrise, i), 0).UTC(),
+			Sunset:                   time.Unix(value(d.Sunset, i), 0).UTC(),
+			Provider:                 providerName,
+		}
+	}
+
+	return result, nil
+}
+
+func (c *Client) get(ctx context.Context, coordinates models.Coordinates, params url.Values, dst any) error {
+	params.Set("latitude", strconv.FormatFloat(coordinates.Latitude, 'f', -1, 64))
+	params.Set("longitude", strconv.FormatFloat(coordinates.Longitude, 'f', -1, 64))
+	params.Set("wind_speed_unit", "ms")
+	params.Set("timeformat", "unixtime")
+	params.Set("timezone", "GMT")
+	if c.apiKey != "" {
+		params.Set("apikey", c.apiKey)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/v1/forecast?"+params.Encode(), nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var res errorResponse
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		if err := json.Unmarshal(body, &res); err != nil || res.Reason == "" {
+			res.Reason = strings.TrimSpace(string(body))
+		}
+
+		return &providers.UpstreamError{
+			Provider:   providerName,
+			StatusCode: resp.StatusCode,
+			Message:    res.Reason,
+		}
+	}
+
+	return json.NewDecoder(resp.Body).Decode(dst)
+}
+
+// at returns the i-th value of the series, nil if it is missing.
+func at[T any](series []*T, i int) *T {
+	if i >= len(series) {
+		return nil
+	}
+	return series[i]
+}
+
+// value returns the i-th value of the series, zero if it is missing.
+func value[T any](series []*T, i int) T {
+	if v := at(series, i); v != nil {
+		return *v
+	}
+	var zero T
+	return zero
+}
+
+// convertWeatherCode converts the WMO weather interpretation code,
+// see https://open-meteo.com/en/docs.
+func convertWeatherCode(code *int) (models.WeatherCondition, string) {
+	if code == nil {
+		return models.ConditionUnknown, ""
+	}
+
+	switch *code {
+	case 0:
+		return models.ConditionClear, "clear sky"
+	case 1:
+		return models.ConditionPartlyCloudy, "mainly clear"
+	case 2:
+		return models.ConditionPartlyCloudy, "partly cloudy"
+	case 3:
+		return models.ConditionCloudy, "overcast"
+	case 45, 48:
+		return models.ConditionFog, "fog"
+	case 51, 53, 55:
+		return models.ConditionDrizzle, "drizzle"
+	case 56, 57:
+		return models.ConditionDrizzle, "freezing drizzle"
+	case 61, 63, 65:
+		return models.ConditionRain, "rain"
+	case 66, 67:
+		return models.ConditionRain, "freezing rain"
+	case 80, 81, 82:
+		return models.ConditionRain, "rain showers"
+	case 71, 73, 75, 77:
+		return models.ConditionSnow, "snow"
+	case 85, 86:
+		return models.ConditionSnow, "snow showers"
+	case 95:
+		return models.ConditionThunderstorm, "thunderstorm"
+	case 96, 99:
+		return models.ConditionThunderstorm, "thunderstorm with hail"
+	}
+	return models.ConditionUnknown, ""
+}
+
+// NewClient creates the client of the API at baseURL, e.g. "https://api.open-meteo.com".
+// The API key is only needed for the commercial API.
+func NewClient(httpClient *http.Client, baseURL string, apiKey string) *Client {
+	return &Client{
+		httpClient: httpClient,
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		apiKey:     apiKey,
+	}
+}
@@ -0,0 +1,58 @@
+package openmeteo
+
+type currentResponse struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Current   struct {
+		Time                int64   `json:"time"`
+		Temperature         float64 `json:"temperature_2m"`
+		ApparentTemperature float64 `json:"apparent_temperature"`
+		RelativeHumidity    float64 `json:"relative_humidity_2m"`
+		PressureMSL         float64 `json:"pressure_msl"`
+		WindSpeed           float64 `json:"wind_speed_10m"`
+		WindGusts           float64 `json:"wind_gusts_10m"`
+		WindDirection       float64 `json:"wind_direction_10m"`
+		WeatherCode         int     `json:"weather_code"`
+	} `json:"current"`
+}
+
+// Forecasts are returned as arrays of values by the time.
+// Values are nullable, when the model has no data for the time.
+
+type hourlyResponse struct {
+	Hourly struct {
+		Time                     []int64    `json:"time"`
+		Temperature              []*float64 `json:"temperature_2m"`
+		ApparentTemperature      []*float64 `json:"apparent_temperature"`
+		RelativeHumidity         []*float64 `json:"relative_humidity_2m"`
+		PressureMSL              []*float64 `json:"pressure_msl"`
+		WindSpeed                []*float64 `json:"wind_speed_10m"`
+		WindGusts                []*float64 `json:"wind_gusts_10m"`
+		WindDirection            []*float64 `json:"wind_direction_10m"`
+		PrecipitationProbability []*float64 `json:"precipitation_probability"`
+		Precipitation            []*float64 `json:"precipitation"`
+		WeatherCode              []*int     `json:"weather_code"`
+	} `json:"hourly"`
+}
+
+type dailyResponse struct {
+	Daily struct {
+		Time                     []int64    `json:"time"`
+		TemperatureMin           []*float64 `json:"temperature_2m_min"`
+		TemperatureMax           []*float64 `json:"temperature_2m_max"`
+		RelativeHumidity         []*float64 `json:"relative_humidity_2m_mean"`
+		PressureMSL              []*float64 `json:"pressure_msl_mean"`
+		WindSpeed                []*float64 `json:"wind_speed_10m_max"`
+		WindGusts                []*float64 `json:"wind_gusts_10m_max"`
+		WindDirection            []*float64 `json:"wind_direction_10m_dominant"`
+		PrecipitationProbability []*float64 `json:"precipitation_probability_max"`
+		Precipitation            []*float64 `json:"precipitation_sum"`
+		WeatherCode              []*int     `json:"weather_code"`
+		Sunrise                  []*int64   `json:"sunrise"`
+		Sunset                   []*int64   `json:"sunset"`
+	} `json:"daily"`
+}
+
+type errorResponse struct {
+	Reason string `json:"reason"`
+}
@@ -0,0 +1,257 @@
+package openweathermap
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+	"github.com/maxdikun/weatherapp/internal/providers"
+)
+
+const providerName = "openweathermap"
+
+const (
+	// forecastStepHours is the length of the steps of the free forecast.
+	forecastStepHours = 3
+	// maxForecastSteps covers the 5 days of the free forecast.
+	maxForecastSteps = 40
+)
+
+// Client is a client of the OpenWeatherMap API 2.5 and compatible ones.
+// Only the endpoints available with the free plan are used, so the forecasts are built from the 3-hour steps.
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+	apiKey     string
+}
+
+var _ providers.WeatherProvider = (*Client)(nil)
+
+// Current implements providers.WeatherProvider.
+func (c *Client) Current(ctx context.Context, coordinates models.Coordinates) (models.CurrentWeather, error) {
+	var res currentResponse
+	if err := c.get(ctx, "/data/2.5/weather", coordinates, nil, &res); err != nil {
+		return models.CurrentWeather{}, fmt.Errorf("openweathermap.Client.Current: %w", err)
+	}
+
+	condition, description := convertConditions(res.Weather)
+
+	return models.CurrentWeather{
+		Coordinates:   models.Coordinates{Latitude: res.Coord.Lat, Longitude: res.Coord.Lon},
+		Temperature:   res.Main.Temp,
+		FeelsLike:     res.Main.FeelsLike,
+		Humidity:      res.Main.Humidity,
+		Pressure:      res.Main.Pressure,
+		WindSpeed:     res.Wind.Speed,
+		WindGust:      res.Wind.Gust,
+		WindDirection: res.Wind.Deg,
+		Condition:     condition,
+		Description:   description,
+		ObservedAt:    time.Unix(res.Dt, 0).UTC(),
+		Provider:      providerName,
+	}, nil
+}
+
+// HourlyForecast implements providers.WeatherProvider.
+// The values of every 3-hour step are used for each of its hours, the precipitation is split between them evenly.
+func (c *Client) HourlyForecast(ctx context.Context, coordinates models.Coordinates, hours int) ([]models.HourlyForecast, error) {
+	// The first step can start up to 3 hours later, so one more step is requested.
+	steps := min(hours/forecastStepHours+2, maxForecastSteps)
+	res, err := c.forecast(ctx, coordinates, steps)
+	if err != nil {
+		return nil, fmt.Errorf("openweathermap.Client.HourlyForecast: %w", err)
+	}
+
+	result := make([]models.HourlyForecast, 0, len(res.List)*forecastStepHours)
+	for _, step := range res.List {
+		condition, description := convertConditions(step.Weather)
+		start := time.Unix(step.Dt, 0).UTC()
+
+		for hour := range forecastStepHours {
+			result = append(result, models.HourlyForecast{
+				Time:                     start.Add(time.Duration(hour) * time.Hour),
+				Temperature:              step.Main.Temp,
+				FeelsLike:                step.Main.FeelsLike,
+				Humidity:                 step.Main.Humidity,
+				Pressure:                 step.Main.Pressure,
+				WindSpeed:                step.Wind.Speed,
+				WindGust:                 step.Wind.Gust,
+				WindDirection:            step.Wind.Deg,
+				PrecipitationProbability: step.Pop * 100,
+				Precipitation:            (step.Rain.ThreeHours + step.Snow.ThreeHours) / forecastStepHours,
+				Condition:                condition,
+				Description:              description,
+				Provider:                 providerName,
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// DailyForecast implements providers.WeatherProvider.
+// The days are aggregated from the 3-hour steps, so at most 5 days are returned. The days after the current one,
+// which aren't covered completely, are dropped. Sunrise and sunset are known only for the current day,
+// so they are shifted by whole days for the next ones.
+func (c *Client) DailyForecast(ctx context.Context, coordinates models.Coordinates, days int) ([]models.DailyForecast, error) {
+	res, err := c.forecast(ctx, coordinates, maxForecastSteps)
+	if err != nil {
+		return nil, fmt.Errorf("openweathermap.Client.DailyForecast: %w", err)
+	}
+
+	var groups [][]forecastStep
+	for _, step := range res.List {
+		date := time.Unix(step.Dt, 0).UTC().Truncate(24 * time.Hour)
+		if len(groups) == 0 || !time.Unix(groups[len(groups)-1][0].Dt, 0).UTC().Truncate(24*time.Hour).Equal(date) {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], step)
+	}
+
+	result := make([]models.DailyForecast, 0, min(len(groups), days))
+	for i, group := range groups {
+		if len(result) == days || (i > 0 && len(group) < 24/forecastStepHours) {
+			break
+		}
+		result = append(result, aggregateDay(group, res.City.Timezone, res.City.Sunrise, res.City.Sunset, i))
+	}
+
+	return result, nil
+}
+
+// aggregateDay combines the steps of the day, which is the offset-th day of the forecast. The condition
+// is taken from the step nearest to the local noon, so it describes the day rather than the night.
+func aggregateDay(steps []forecastStep, timezone int64, sunrise int64, sunset int64, offset int) models.DailyForecast {
+	date := time.Unix(steps[0].Dt, 0).UTC().Truncate(24 * time.Hour)
+	localNoon := date.Add(12*time.Hour - time.Duration(timezone)*time.Second)
+
+	day := models.DailyForecast{
+		Date:           date,
+		TemperatureMin: steps[0].Main.Temp,
+		TemperatureMax: steps[0].Main.Temp,
+		Provider:       providerName,
+	}
+	if sunrise != 0 && sunset != 0 {
+		shift := time.Duration(offset) * 24 * time.Hour
+		day.Sunrise = time.Unix(sunrise, 0).UTC().Add(shift)
+		day.Sunset = time.Unix(sunset, 0).UTC().Add(shift)
+	}
+
+	strongest := -1.0
+	noonDistance := time.Duration(-1)
+	for _, step := range steps {
+		day.TemperatureMin = min(day.TemperatureMin, step.Main.Temp)
+		day.TemperatureMax = max(day.TemperatureMax, step.Main.Temp)
+		day.Humidity += step.Main.Humidity / float64(len(steps))
+		day.Pressure += step.Main.Pressure / float64(len(steps))
+		day.WindGust = max(day.WindGust, step.Wind.Gust)
+		day.PrecipitationProbability = max(day.PrecipitationProbability, step.Pop*100)
+		day.Precipitation += step.Rain.ThreeHours + step.Snow.ThreeHours
+
+		if step.Wind.Speed > strongest {
+			strongest = step.Wind.Speed
+			day.WindSpeed = step.Wind.Speed
+			day.WindDirection = step.Wind.Deg
+		}
+
+		distance := time.Unix(step.Dt, 0).Sub(localNoon).Abs()
+		if noonDistance < 0 || distance < noonDistance {
+			noonDistance = distance
+			day.Condition, day.Description = convertConditions(step.Weather)
+		}
+	}
+
+	return day
+}
+
+// forecast requests the free 5 day forecast in 3-hour steps.
+func (c *Client) forecast(ctx context.Context, coordinates models.Coordinates, steps int) (forecastResponse, error) {
+	var res forecastResponse
+	params := url.Values{"cnt": {strconv.Itoa(steps)}}
+	if err := c.get(ctx, "/data/2.5/forecast", coordinates, params, &res); err != nil {
+		return forecastResponse{}, err
+	}
+	return res, nil
+}
+
+func (c *Client) get(ctx context.Context, path string, coordinates models.Coordinates, params url.Values, dst any) error {
+	if params == nil {
+		params = url.Values{}
+	}
+	params.Set("lat", strconv.FormatFloat(coordinates.Latitude, 'f', -1, 64))
+	params.Set("lon", strconv.FormatFloat(coordinates.Longitude, 'f', -1, 64))
+	params.Set("units", "metric")
+	params.Set("appid", c.apiKey)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path+"?"+params.Encode(), nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var res errorResponse
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		if err := json.Unmarshal(body, &res); err != nil || res.Message == "" {
+			res.Message = strings.TrimSpace(string(body))
+		}
+
+		return &providers.UpstreamError{
+			Provider:   providerName,
+			StatusCode: resp.StatusCode,
+			Message:    res.Message,
+		}
+	}
+
+	return json.NewDecoder(resp.Body).Decode(dst)
+}
+
+// convertConditions converts the first of OpenWeatherMap conditions,
+// see https://openweathermap.org/weather-conditions.
+func convertConditions(conditions []condition) (models.WeatherCondition, string) {
+	if len(conditions) == 0 {
+		return models.ConditionUnknown, ""
+	}
+
+	id := conditions[0].Id
+	switch {
+	case id >= 200 && id < 300:
+		return models.ConditionThunderstorm, conditions[0].Description
+	case id >= 300 && id < 400:
+		return models.ConditionDrizzle, conditions[0].Description
+	case id >= 500 && id < 600:
+		return models.ConditionRain, conditions[0].Description
+	case id >= 600 && id < 700:
+		return models.ConditionSnow, conditions[0].Description
+	case id >= 700 && id < 800:
+		return models.ConditionFog, conditions[0].Description
+	case id == 800:
+		return models.ConditionClear, conditions[0].Description
+	case id == 801 || id == 802:
+		return models.ConditionPartlyCloudy, conditions[0].Description
+	case id == 803 || id == 804:
+		return models.ConditionCloudy, conditions[0].Description
+	}
+	return models.ConditionUnknown, conditions[0].Description
+}
+
+// NewClient creates the client of the API at baseURL, e.g. "https://api.openweathermap.org".
+func NewClient(httpClient *http.Client, baseURL string, apiKey string) *Client {
+	return &Client{
+		httpClient: httpClient,
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		apiKey:     apiKey,
+	}
+}
@@ -0,0 +1,243 @@
+package openweathermap
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+	"github.com/maxdikun/weatherapp/internal/providers"
+)
+
+var testCoordinates = models.Coordinates{Latitude: 52.52, Longitude: 13.405}
+
+// newTestClient starts a stand-in of the API, which records the request and responds with the status and the body.
+func newTestClient(t *testing.T, status int, body any) (*Client, *http.Request) {
+	t.Helper()
+
+	var recorded http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recorded = *r
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+	t.Cleanup(server.Close)
+
+	return NewClient(server.Client(), server.URL+"/", "test-key"), &recorded
+}
+
+func assertRequest(t *testing.T, r *http.Request, path string, extra url.Values) {
+	t.Helper()
+
+	if r.URL.Path != path {
+		t.Errorf("path = %q, want %q", r.URL.Path, path)
+	}
+
+	want := url.Values{
+		"lat":   {"52.52"},
+		"lon":   {"13.405"},
+		"units": {"metric"},
+		"appid": {"test-key"},
+	}
+	for key, values := range extra {
+		want[key] = values
+	}
+
+	query := r.URL.Query()
+	if len(query) != len(want) {
+		t.Errorf("query = %v, want %v", query, want)
+	}
+	for key := range want {
+		if query.Get(key) != want.Get(key) {
+			t.Errorf("query %s = %q, want %q", key, query.Get(key), want.Get(key))
+		}
+	}
+}
+
+// step builds a forecast step of the stand-in response.
+func step(at time.Time, temp float64, windSpeed float64, conditionId int) map[string]any {
+	return map[string]any{
+		"dt":      at.Unix(),
+		"main":    map[string]any{"temp": temp, "feels_like": temp - 1, "pressure": 1010, "humidity": 60},
+		"weather": []map[string]any{{"id": conditionId, "description": "test"}},
+		"wind":    map[string]any{"speed": windSpeed, "deg": 90, "gust": windSpeed * 2},
+		"pop":     0.5,
+		"rain":    map[string]any{"3h": 1.5},
+		"snow":    map[string]any{"3h": 1.5},
+	}
+}
+
+func TestClientCurrent(t *testing.T) {
+	observedAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	client, r := newTestClient(t, http.StatusOK, map[string]any{
+		"coord":   map[string]any{"lat": 52.52, "lon": 13.41},
+		"weather": []map[string]any{{"id": 801, "description": "few clouds"}},
+		"main":    map[string]any{"temp": 21.5, "feels_like": 20.9, "pressure": 1015, "humidity": 40},
+		"wind":    map[string]any{"speed": 3.6, "deg": 250, "gust": 7.2},
+		"dt":      observedAt.Unix(),
+	})
+
+	weather, err := client.Current(context.Background(), testCoordinates)
+	if err != nil {
+		t.Fatalf("Current() error = %v", err)
+	}
+	assertRequest(t, r, "/data/2.5/weather", nil)
+
+	want := models.CurrentWeather{
+		Coordinates:   models.Coordinates{Latitude: 52.52, Longitude: 13.41},
+		Temperature:   21.5,
+		FeelsLike:     20.9,
+		Humidity:      40,
+		Pressure:      1015,
+		WindSpeed:     3.6,
+		WindGust:      7.2,
+		WindDirection: 250,
+		Condition:     models.ConditionPartlyCloudy,
+		Description:   "few clouds",
+		ObservedAt:    observedAt,
+		Provider:      providerName,
+	}
+	if weather != want {
+		t.Errorf("Current() = %+v, want %+v", weather, want)
+	}
+}
+
+func TestClientHourlyForecast(t *testing.T) {
+	start := time.Date(2025, 6, 1, 15, 0, 0, 0, time.UTC)
+	client, r := newTestClient(t, http.StatusOK, map[string]any{
+		"list": []map[string]any{
+			step(start, 20, 3, 500),
+			step(start.Add(3*time.Hour), 17, 2, 800),
+		},
+	})
+
+	forecast, err := client.HourlyForecast(context.Background(), testCoordinates, 48)
+	if err != nil {
+		t.Fatalf("HourlyForecast() error = %v", err)
+	}
+	assertRequest(t, r, "/data/2.5/forecast", url.Values{"cnt": {"18"}})
+
+	if len(forecast) != 6 {
+		t.Fatalf("len(HourlyForecast()) = %d, want 6", len(forecast))
+	}
+	for i, hour := range forecast {
+		if want := start.Add(time.Duration(i) * time.Hour); !hour.Time.Equal(want) {
+			t.Errorf("forecast[%d].Time = %v, want %v", i, hour.Time, want)
+		}
+		// Rain and snow of the step are split between its hours.
+		if hour.Precipitation != 1 {
+			t.Errorf("forecast[%d].Precipitation = %v, want 1", i, hour.Precipitation)
+		}
+		if hour.PrecipitationProbability != 50 {
+			t.Errorf("forecast[%d].PrecipitationProbability = %v, want 50", i, hour.PrecipitationProbability)
+		}
+	}
+	if forecast[2].Temperature != 20 || forecast[2].Condition != models.ConditionRain {
+		t.Errorf("forecast[2] = %+v, want the values of the first step", forecast[2])
+	}
+	if forecast[3].Temperature != 17 || forecast[3].Condition != models.ConditionClear {
+		t.Errorf("forecast[3] = %+v, want the values of the second step", forecast[3])
+	}
+}
+
+func TestClientHourlyForecastRequestsAtMostFiveDays(t *testing.T) {
+	client, r := newTestClient(t, http.StatusOK, map[string]any{"list": []any{}})
+
+	if _, err := client.HourlyForecast(context.Background(), testCoordinates, 240); err != nil {
+		t.Fatalf("HourlyForecast() error = %v", err)
+	}
+	assertRequest(t, r, "/data/2.5/forecast", url.Values{"cnt": {"40"}})
+}
+
+func TestClientDailyForecast(t *testing.T) {
+	today := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	tomorrow := today.Add(24 * time.Hour)
+	sunrise := today.Add(3 * time.Hour)
+	sunset := today.Add(19 * time.Hour)
+
+	list := []map[string]any{step(today.Add(21*time.Hour), 15, 2, 800)}
+	temperatures := []float64{12, 11, 13, 18, 22, 24, 19, 14}
+	for i, temperature := range temperatures {
+		// The strongest wind is at 15:00, the step at 09:00 is the nearest to the local noon at 10:00 UTC.
+		windSpeed := 2.0
+		conditionId := 800
+		switch i {
+		case 3:
+			conditionId = 501
+		case 5:
+			windSpeed = 9
+		}
+		list = append(list, step(tomorrow.Add(time.Duration(i*3)*time.Hour), temperature, windSpeed, conditionId))
+	}
+	// The day after tomorrow isn't covered completely, so it's dropped.
+	list = append(list, step(tomorrow.Add(24*time.Hour), 10, 2, 800))
+
+	response := map[string]any{
+		"list": list,
+		"city": map[string]any{"timezone": 7200, "sunrise": sunrise.Unix(), "sunset": sunset.Unix()},
+	}
+	client, r := newTestClient(t, http.StatusOK, response)
+
+	forecast, err := client.DailyForecast(context.Background(), testCoordinates, 16)
+	if err != nil {
+		t.Fatalf("DailyForecast() error = %v", err)
+	}
+	assertRequest(t, r, "/data/2.5/forecast", url.Values{"cnt": {"40"}})
+
+	if len(forecast) != 2 {
+		t.Fatalf("len(DailyForecast()) = %d, want 2", len(forecast))
+	}
+	if !forecast[0].Date.Equal(today) || forecast[0].TemperatureMax != 15 {
+		t.Errorf("forecast[0] = %+v, want the partial current day", forecast[0])
+	}
+
+	want := models.DailyForecast{
+		Date:                     tomorrow,
+		TemperatureMin:           11,
+		TemperatureMax:           24,
+		Humidity:                 60,
+		Pressure:                 1010,
+		WindSpeed:                9,
+		WindGust:                 18,
+		WindDirection:            90,
+		PrecipitationProbability: 50,
+		Precipitation:            24,
+		Condition:                models.ConditionRain,
+		Description:              "test",
+		Sunrise:                  sunrise.Add(24 * time.Hour),
+		Sunset:                   sunset.Add(24 * time.Hour),
+		Provider:                 providerName,
+	}
+	if forecast[1] != want {
+		t.Errorf("forecast[1] = %+v, want %+v", forecast[1], want)
+	}
+
+	client, _ = newTestClient(t, http.StatusOK, response)
+	forecast, err = client.DailyForecast(context.Background(), testCoordinates, 1)
+	if err != nil {
+		t.Fatalf("DailyForecast() error = %v", err)
+	}
+	if len(forecast) != 1 {
+		t.Errorf("len(DailyForecast(1)) = %d, want 1", len(forecast))
+	}
+}
+
+func TestClientUpstreamError(t *testing.T) {
+	client, _ := newTestClient(t, http.StatusUnauthorized, map[string]any{"cod": 401, "message": "Invalid API key"})
+
+	_, err := client.Current(context.Background(), testCoordinates)
+
+	var upstream *providers.UpstreamError
+	if !errors.As(err, &upstream) {
+		t.Fatalf("Current() error = %v, want *providers.UpstreamError", err)
+	}
+	if upstream.StatusCode != http.StatusUnauthorized || upstream.Message != "Invalid API key" {
+		t.Errorf("UpstreamError = %+v, want status 401 with the message of the API", upstream)
+	}
+}
@@ -0,0 +1,60 @@
+package openweathermap
+
+type condition struct {
+	Id          int    `json:"id"`
+	Main        string `json:"main"`
+	Description string `json:"description"`
+}
+
+type mainValues struct {
+	Temp      float64 `json:"temp"`
+	FeelsLike float64 `json:"feels_like"`
+	Pressure  float64 `json:"pressure"`
+	Humidity  float64 `json:"humidity"`
+}
+
+type wind struct {
+	Speed float64 `json:"speed"`
+	Deg   float64 `json:"deg"`
+	Gust  float64 `json:"gust"`
+}
+
+// precipitation is the amount in mm over the last 3 hours of the forecast step.
+type precipitation struct {
+	ThreeHours float64 `json:"3h"`
+}
+
+type currentResponse struct {
+	Coord struct {
+		Lat float64 `json:"lat"`
+		Lon float64 `json:"lon"`
+	} `json:"coord"`
+	Weather []condition `json:"weather"`
+	Main    mainValues  `json:"main"`
+	Wind    wind        `json:"wind"`
+	Dt      int64       `json:"dt"`
+}
+
+type forecastStep struct {
+	Dt      int64         `json:"dt"`
+	Main    mainValues    `json:"main"`
+	Weather []condition   `json:"weather"`
+	Wind    wind          `json:"wind"`
+	Pop     float64       `json:"pop"`
+	Rain    precipitation `json:"rain"`
+	Snow    precipitation `json:"snow"`
+}
+
+type forecastResponse struct {
+	List []forecastStep `json:"list"`
+	City struct {
+		// Timezone is the shift from UTC in seconds.
+		Timezone int64 `json:"timezone"`
+		Sunrise  int64 `json:"sunrise"`
+		Sunset   int64 `json:"sunset"`
+	} `json:"city"`
+}
+
+type errorResponse struct {
+	Message string `json:"message"`
+}
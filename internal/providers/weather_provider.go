@@ -0,0 +1,17 @@
+package providers
+
+import (
+	"context"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+)
+
+// WeatherProvider is a source of the weather data.
+type WeatherProvider interface {
+	// Current returns the latest observation for the coordinates.
+	Current(ctx context.Context, coordinates models.Coordinates) (models.CurrentWeather, error)
+	// HourlyForecast returns forecasts for the next hours, starting from the current one.
+	HourlyForecast(ctx context.Context, coordinates models.Coordinates, hours int) ([]models.HourlyForecast, error)
+	// DailyForecast returns forecasts for the next days, starting from today.
+	DailyForecast(ctx context.Context, coordinates models.Coordinates, days int) ([]models.DailyForecast, error)
+}
@@ -0,0 +1,28 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+)
+
+type AlertRepository interface {
+	FindSubscriptionById(ctx context.Context, id uuid.UUID) (models.AlertSubscription, error)
+	// FindSubscriptionsByUser returns subscriptions of the user in the order of creation.
+	FindSubscriptionsByUser(ctx context.Context, user uuid.UUID) ([]models.AlertSubscription, error)
+	// FindTargets returns every subscription with the data needed to evaluate it, grouped by location.
+	FindTargets(ctx context.Context) ([]models.AlertTarget, error)
+	// AddSubscription fails with LimitExceededError if the user already has limit subscriptions.
+	AddSubscription(ctx context.Context, subscription models.AlertSubscription, limit int) error
+	// UpdateSubscription changes the rule of the subscription, its location is left as is.
+	UpdateSubscription(ctx context.Context, subscription models.AlertSubscription) error
+	DeleteSubscription(ctx context.Context, id uuid.UUID) error
+
+	// AddAlert records the triggered alert together with the notification about it, so either both are stored or none.
+	// It fails with AlreadyExistsError if the subscription has already triggered on the day of the alert.
+	AddAlert(ctx context.Context, alert models.Alert, notification models.Notification) error
+	// FindAlertsByUser returns at most limit alerts of the user, the latest come first.
+	FindAlertsByUser(ctx context.Context, user uuid.UUID, limit int) ([]models.Alert, error)
+}
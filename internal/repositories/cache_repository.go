@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+	"time"
+)
+
+// CacheEntry is a cached value, which is considered fresh until StaleAt.
+type CacheEntry struct {
+	Value   []byte
+	StaleAt time.Time
+}
+
+// CacheRepository keeps cached values, which are shared by every replica of the app.
+type CacheRepository interface {
+	// Get returns the entry stored by the key.
+	Get(ctx context.Context, key string) (CacheEntry, error)
+	// Set stores the entry by the key for the ttl.
+	Set(ctx context.Context, key string, entry CacheEntry, ttl time.Duration) error
+	// TryLock locks the key for the duration, it returns false if the key is already locked.
+	TryLock(ctx context.Context, key string, duration time.Duration) (bool, error)
+}
@@ -24,3 +24,16 @@ var _ error = (*NotFoundError)(nil)
 func (err *NotFoundError) Error() string {
 	return fmt.Sprintf("object '%s' not found by given value for the field '%s'", err.Object, err.Field)
 }
+
+// LimitExceededError is returned when the owner already has the maximum number of objects.
+type LimitExceededError struct {
+	Object string
+	Limit  int
+}
+
+var _ error = (*LimitExceededError)(nil)
+
+// Error implements error.
+func (err *LimitExceededError) Error() string {
+	return fmt.Sprintf("limit of %d objects '%s' is exceeded", err.Limit, err.Object)
+}
@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+)
+
+// EventRepository delivers the events to every replica of the app.
+// Delivery is best effort, the events published while a replica is disconnected are lost for it.
+type EventRepository interface {
+	Publish(ctx context.Context, event models.Event) error
+	// Subscribe calls handle for every published event until the context is canceled.
+	// It returns after the subscription is established and handles the events in the background.
+	Subscribe(ctx context.Context, handle func(event models.Event)) error
+}
@@ -0,0 +1,23 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+)
+
+type LocationRepository interface {
+	FindById(ctx context.Context, id uuid.UUID) (models.Location, error)
+	// FindByUser returns locations of the user ordered by their position.
+	FindByUser(ctx context.Context, user uuid.UUID) ([]models.Location, error)
+	// Add stores the location after the other locations of the user and returns it with the position.
+	// It fails with LimitExceededError if the user already has limit locations.
+	Add(ctx context.Context, location models.Location, limit int) (models.Location, error)
+	// Update changes the name and coordinates of the location.
+	Update(ctx context.Context, location models.Location) error
+	// Reorder sets positions of the locations of the user to their indexes in ids.
+	Reorder(ctx context.Context, user uuid.UUID, ids []uuid.UUID) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,29 @@
+package repositories
+
+import (
+	"context"
+	"time"
+)
+
+// AttemptKey is a key of the counter of the attempts with its limit.
+type AttemptKey struct {
+	Name  string
+	Limit int
+}
+
+// LoginAttemptRepository keeps counters of authentication attempts and lockouts.
+type LoginAttemptRepository interface {
+	// Attempt counts the attempt for every key, unless any of them is locked out, and returns
+	// the longest remaining lockout then. The check and the counting are atomic, so the concurrent
+	// attempts can't pass the check before any of them is counted.
+	//
+	// The attempt, which reaches the limit of the key, locks it out in advance for the lockout,
+	// which doubles with every attempt over the limit up to maxLockout, so no other attempt is made
+	// while it's verified. Counters are dropped when no attempts happen during the window.
+	Attempt(ctx context.Context, keys []AttemptKey, window time.Duration, lockout time.Duration, maxLockout time.Duration) (time.Duration, error)
+	// Release undoes the counted attempt of the key, which hasn't failed. The lockout isn't lifted,
+	// because it can't be told apart from the one set by a concurrent failed attempt.
+	Release(ctx context.Context, key string) error
+	// Reset drops the counter and the lockout of the key.
+	Reset(ctx context.Context, key string) error
+}
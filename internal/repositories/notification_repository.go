@@ -0,0 +1,33 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+)
+
+// NotificationRepository is the outbox of the notifications and the settings of their channels.
+type NotificationRepository interface {
+	// Add stores the notification in the outbox, it is due immediately.
+	Add(ctx context.Context, notification models.Notification) error
+	// Claim returns at most limit notifications due at now and postpones them until leaseUntil,
+	// so other dispatchers skip them. Attempts of the claimed notifications are incremented.
+	Claim(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]models.Notification, error)
+	// MarkDelivered records that the channel has received the notification.
+	MarkDelivered(ctx context.Context, id uuid.UUID, channel string) error
+	// Complete removes the notification from the queue after it has been delivered to every channel.
+	// Complete and Fail clear the message of models.NotificationEmailVerification, because it contains the code.
+	Complete(ctx context.Context, id uuid.UUID, at time.Time) error
+	// Retry schedules the next attempt of the notification.
+	Retry(ctx context.Context, id uuid.UUID, at time.Time, lastError string) error
+	// Fail removes the notification from the queue without delivering it.
+	Fail(ctx context.Context, id uuid.UUID, at time.Time, lastError string) error
+
+	// FindSettings fails with NotFoundError if the user has never saved the settings.
+	FindSettings(ctx context.Context, user uuid.UUID) (models.NotificationSettings, error)
+	// SaveSettings creates or replaces the settings of the user.
+	SaveSettings(ctx context.Context, settings models.NotificationSettings) error
+}
@@ -0,0 +1,296 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+	"github.com/maxdikun/weatherapp/internal/repositories"
+	"github.com/maxdikun/weatherapp/internal/repositories/postgres/gen"
+)
+
+type AlertRepository struct {
+	pool *pgxpool.Pool
+}
+
+var _ repositories.AlertRepository = (*AlertRepository)(nil)
+
+// FindSubscriptionById implements repositories.AlertRepository.
+func (a *AlertRepository) FindSubscriptionById(ctx context.Context, id uuid.UUID) (models.AlertSubscription, error) {
+	queries := gen.New(a.pool)
+
+	result, err := queries.SelectAlertSubscriptionById(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.AlertSubscription{}, &repositories.NotFoundError{
+				Object: "alert subscription",
+				Field:  "id",
+			}
+		}
+
+		return models.AlertSubscription{}, fmt.Errorf("postgres.AlertRepository.FindSubscriptionById: %w", err)
+	}
+
+	return toAlertSubscriptionModel(result), nil
+}
+
+// FindSubscriptionsByUser implements repositories.AlertRepository.
+func (a *AlertRepository) FindSubscriptionsByUser(ctx context.Context, user uuid.UUID) ([]models.AlertSubscription, error) {
+	queries := gen.New(a.pool)
+
+	results, err := queries.SelectAlertSubscriptionsByUser(ctx, user)
+	if err != nil {
+		return nil, fmt.Errorf("postgres.AlertRepository.FindSubscriptionsByUser: %w", err)
+	}
+
+	subscriptions := make([]models.AlertSubscription, len(results))
+	for i, result := range results {
+		subscriptions[i] = toAlertSubscriptionModel(result)
+	}
+
+	return subscriptions, nil
+}
+
+// FindTargets implements repositories.AlertRepository.
+func (a *AlertRepository) FindTargets(ctx context.Context) ([]models.AlertTarget, error) {
+	queries := gen.New(a.pool)
+
+	results, err := queries.SelectAlertTargets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("postgres.AlertRepository.FindTargets: %w", err)
+	}
+
+	targets := make([]models.AlertTarget, len(results))
+	for i, result := range results {
+		targets[i] = models.AlertTarget{
+			Subscription: toAlertSubscriptionModel(gen.AlertSubscription{
+				ID:         result.ID,
+				UserID:     result.UserID,
+				LocationID: result.LocationID,
+				Metric:     result.Metric,
+				Operator:   result.Operator,
+				Threshold:  result.Threshold,
+				Period:     result.Period,
+				CreatedAt:  result.CreatedAt,
+			}),
+			LocationName: result.LocationName,
+			Coordinates: models.Coordinates{
+				Latitude:  result.Latitude,
+				Longitude: result.Longitude,
+			},
+			TimeZone: result.TimeZone,
+		}
+	}
+
+	return targets, nil
+}
+
+// AddSubscription implements repositories.AlertRepository.
+func (a *AlertRepository) AddSubscription(ctx context.Context, subscription models.AlertSubscription, limit int) error {
+	tx, err := a.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("postgres.AlertRepository.AddSubscription: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	queries := gen.New(tx)
+
+	// The user row serializes concurrent additions, so the limit can't be exceeded by a race.
+	if _, err := queries.LockUser(ctx, subscription.User); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &repositories.NotFoundError{
+				Object: "user",
+				Field:  "id",
+			}
+		}
+		return fmt.Errorf("postgres.AlertRepository.AddSubscription: %w", err)
+	}
+
+	count, err := queries.CountAlertSubscriptionsByUser(ctx, subscription.User)
+	if err != nil {
+		return fmt.Errorf("postgres.AlertRepository.AddSubscription: %w", err)
+	}
+	if int(count) >= limit {
+		return &repositories.LimitExceededError{
+			Object: "alert subscription",
+			Limit:  limit,
+		}
+	}
+
+	err = queries.InsertAlertSubscription(ctx, gen.InsertAlertSubscriptionParams{
+		ID:         subscription.Id,
+		UserID:     subscription.User,
+		LocationID: subscription.Location,
+		Metric:     string(subscription.Metric),
+		Operator:   string(subscription.Operator),
+		Threshold:  subscription.Threshold,
+		Period:     string(subscription.Period),
+		CreatedAt:  subscription.CreatedAt,
+	})
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return &repositories.NotFoundError{
+				Object: "location",
+				Field:  "id",
+			}
+		}
+		return fmt.Errorf("postgres.AlertRepository.AddSubscription: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("postgres.AlertRepository.AddSubscription: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateSubscription implements repositories.AlertRepository.
+func (a *AlertRepository) UpdateSubscription(ctx context.Context, subscription models.AlertSubscription) error {
+	queries := gen.New(a.pool)
+
+	rows, err := queries.UpdateAlertSubscription(ctx, gen.UpdateAlertSubscriptionParams{
+		ID:        subscription.Id,
+		Metric:    string(subscription.Metric),
+		Operator:  string(subscription.Operator),
+		Threshold: subscription.Threshold,
+		Period:    string(subscription.Period),
+	})
+	if err != nil {
+		return fmt.Errorf("postgres.AlertRepository.UpdateSubscription: %w", err)
+	}
+
+	if rows == 0 {
+		return &repositories.NotFoundError{
+			Object: "alert subscription",
+			Field:  "id",
+		}
+	}
+
+	return nil
+}
+
+// DeleteSubscription implements repositories.AlertRepository.
+func (a *AlertRepository) DeleteSubscription(ctx context.Context, id uuid.UUID) error {
+	queries := gen.New(a.pool)
+
+	rows, err := queries.DeleteAlertSubscription(ctx, id)
+	if err != nil {
+		return fmt.Errorf("postgres.AlertRepository.DeleteSubscription: %w", err)
+	}
+
+	if rows == 0 {
+		return &repositories.NotFoundError{
+			Object: "alert subscription",
+			Field:  "id",
+		}
+	}
+
+	return nil
+}
+
+// AddAlert implements repositories.AlertRepository.
+func (a *AlertRepository) AddAlert(ctx context.Context, alert models.Alert, notification models.Notification) error {
+	tx, err := a.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("postgres.AlertRepository.AddAlert: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	queries := gen.New(tx)
+
+	rows, err := queries.InsertAlert(ctx, gen.InsertAlertParams{
+		ID:             alert.Id,
+		SubscriptionID: alert.Subscription,
+		UserID:         alert.User,
+		LocationID:     alert.Location,
+		Metric:         string(alert.Metric),
+		Operator:       string(alert.Operator),
+		Threshold:      alert.Threshold,
+		Value:          alert.Value,
+		ForecastTime:   alert.ForecastTime,
+		Day:            alert.Day,
+		TriggeredAt:    alert.TriggeredAt,
+	})
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return &repositories.NotFoundError{
+				Object: "alert subscription",
+				Field:  "id",
+			}
+		}
+		return fmt.Errorf("postgres.AlertRepository.AddAlert: %w", err)
+	}
+
+	if rows == 0 {
+		return &repositories.AlreadyExistsError{
+			Object: "alert",
+			Field:  "day",
+		}
+	}
+
+	if err := insertNotification(ctx, queries, notification); err != nil {
+		return fmt.Errorf("postgres.AlertRepository.AddAlert: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("postgres.AlertRepository.AddAlert: %w", err)
+	}
+
+	return nil
+}
+
+// FindAlertsByUser implements repositories.AlertRepository.
+func (a *AlertRepository) FindAlertsByUser(ctx context.Context, user uuid.UUID, limit int) ([]models.Alert, error) {
+	queries := gen.New(a.pool)
+
+	results, err := queries.SelectAlertsByUser(ctx, gen.SelectAlertsByUserParams{
+		UserID: user,
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("postgres.AlertRepository.FindAlertsByUser: %w", err)
+	}
+
+	alerts := make([]models.Alert, len(results))
+	for i, result := range results {
+		alerts[i] = models.Alert{
+			Id:           result.ID,
+			Subscription: result.SubscriptionID,
+			User:         result.UserID,
+			Location:     result.LocationID,
+			Metric:       models.AlertMetric(result.Metric),
+			Operator:     models.AlertOperator(result.Operator),
+			Threshold:    result.Threshold,
+			Value:        result.Value,
+			ForecastTime: result.ForecastTime,
+			Day:          result.Day,
+			TriggeredAt:  result.TriggeredAt,
+		}
+	}
+
+	return alerts, nil
+}
+
+func toAlertSubscriptionModel(subscription gen.AlertSubscription) models.AlertSubscription {
+	return models.AlertSubscription{
+		Id:        subscription.ID,
+		User:      subscription.UserID,
+		Location:  subscription.LocationID,
+		Metric:    models.AlertMetric(subscription.Metric),
+		Operator:  models.AlertOperator(subscription.Operator),
+		Threshold: subscription.Threshold,
+		Period:    models.AlertPeriod(subscription.Period),
+		CreatedAt: subscription.CreatedAt,
+	}
+}
+
+func NewAlertRepository(pool *pgxpool.Pool) *AlertRepository {
+	return &AlertRepository{
+		pool: pool,
+	}
+}
@@ -0,0 +1,299 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: alerts.sql
+
+package gen
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const countAlertSubscriptionsByUser = `-- name: CountAlertSubscriptionsByUser :one
+SELECT COUNT(*)::int
+FROM alert_subscriptions
+WHERE user_id = $1
+`
+
+func (q *Queries) CountAlertSubscriptionsByUser(ctx context.Context, userID uuid.UUID) (int32, error) {
+	row := q.db.QueryRow(ctx, countAlertSubscriptionsByUser, userID)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const deleteAlertSubscription = `-- name: DeleteAlertSubscription :execrows
+DELETE FROM alert_subscriptions
+WHERE id = $1
+`
+
+func (q *Queries) DeleteAlertSubscription(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteAlertSubscription, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const insertAlert = `-- name: InsertAlert :execrows
+INSERT INTO alerts (
+    id, subscription_id, user_id, location_id, metric, operator, threshold, value, forecast_time, day, triggered_at
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+ON CONFLICT (subscription_id, day) DO NOTHING
+`
+
+type InsertAlertParams struct {
+	ID             uuid.UUID
+	SubscriptionID uuid.UUID
+	UserID         uuid.UUID
+	LocationID     uuid.UUID
+	Metric         string
+	Operator       string
+	Threshold      float64
+	Value          float64
+	ForecastTime   time.Time
+	Day            time.Time
+	TriggeredAt    time.Time
+}
+
+func (q *Queries) InsertAlert(ctx context.Context, arg InsertAlertParams) (int64, error) {
+	result, err := q.db.Exec(ctx, insertAlert,
+		arg.ID,
+		arg.SubscriptionID,
+		arg.UserID,
+		arg.LocationID,
+		arg.Metric,
+		arg.Operator,
+		arg.Threshold,
+		arg.Value,
+		arg.ForecastTime,
+		arg.Day,
+		arg.TriggeredAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const insertAlertSubscription = `-- name: InsertAlertSubscription :exec
+INSERT INTO alert_subscriptions (id, user_id, location_id, metric, operator, threshold, period, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+
+type InsertAlertSubscriptionParams struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	LocationID uuid.UUID
+	Metric     string
+	Operator   string
+	Threshold  float64
+	Period     string
+	CreatedAt  time.Time
+}
+
+func (q *Queries) InsertAlertSubscription(ctx context.Context, arg InsertAlertSubscriptionParams) error {
+	_, err := q.db.Exec(ctx, insertAlertSubscription,
+		arg.ID,
+		arg.UserID,
+		arg.LocationID,
+		arg.Metric,
+		arg.Operator,
+		arg.Threshold,
+		arg.Period,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const selectAlertSubscriptionById = `-- name: SelectAlertSubscriptionById :one
+SELECT id, user_id, location_id, metric, operator, threshold, period, created_at
+FROM alert_subscriptions
+WHERE id = $1
+`
+
+func (q *Queries) SelectAlertSubscriptionById(ctx context.Context, id uuid.UUID) (AlertSubscription, error) {
+	row := q.db.QueryRow(ctx, selectAlertSubscriptionById, id)
+	var i AlertSubscription
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.LocationID,
+		&i.Metric,
+		&i.Operator,
+		&i.Threshold,
+		&i.Period,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const selectAlertSubscriptionsByUser = `-- name: SelectAlertSubscriptionsByUser :many
+SELECT id, user_id, location_id, metric, operator, threshold, period, created_at
+FROM alert_subscriptions
+WHERE user_id = $1
+ORDER BY created_at, id
+`
+
+func (q *Queries) SelectAlertSubscriptionsByUser(ctx context.Context, userID uuid.UUID) ([]AlertSubscription, error) {
+	rows, err := q.db.Query(ctx, selectAlertSubscriptionsByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AlertSubscription
+	for rows.Next() {
+		var i AlertSubscription
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.LocationID,
+			&i.Metric,
+			&i.Operator,
+			&i.Threshold,
+			&i.Period,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const selectAlertTargets = `-- name: SelectAlertTargets :many
+SELECT s.id, s.user_id, s.location_id, s.metric, s.operator, s.threshold, s.period, s.created_at,
+       l.name AS location_name, l.latitude, l.longitude, COALESCE(p.time_zone, 'UTC')::text AS time_zone
+FROM alert_subscriptions s
+JOIN locations l ON l.id = s.location_id
+LEFT JOIN user_preferences p ON p.user_id = s.user_id
+ORDER BY s.location_id
+`
+
+type SelectAlertTargetsRow struct {
+	ID           uuid.UUID
+	UserID       uuid.UUID
+	LocationID   uuid.UUID
+	Metric       string
+	Operator     string
+	Threshold    float64
+	Period       string
+	CreatedAt    time.Time
+	LocationName string
+	Latitude     float64
+	Longitude    float64
+	TimeZone     string
+}
+
+func (q *Queries) SelectAlertTargets(ctx context.Context) ([]SelectAlertTargetsRow, error) {
+	rows, err := q.db.Query(ctx, selectAlertTargets)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SelectAlertTargetsRow
+	for rows.Next() {
+		var i SelectAlertTargetsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.LocationID,
+			&i.Metric,
+			&i.Operator,
+			&i.Threshold,
+			&i.Period,
+			&i.CreatedAt,
+			&i.LocationName,
+			&i.Latitude,
+			&i.Longitude,
+			&i.TimeZone,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const selectAlertsByUser = `-- name: SelectAlertsByUser :many
+SELECT id, subscription_id, user_id, location_id, metric, operator, threshold, value, forecast_time, day, triggered_at
+FROM alerts
+WHERE user_id = $1
+ORDER BY triggered_at DESC, id
+LIMIT $2
+`
+
+type SelectAlertsByUserParams struct {
+	UserID uuid.UUID
+	Limit  int32
+}
+
+func (q *Queries) SelectAlertsByUser(ctx context.Context, arg SelectAlertsByUserParams) ([]Alert, error) {
+	rows, err := q.db.Query(ctx, selectAlertsByUser, arg.UserID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Alert
+	for rows.Next() {
+		var i Alert
+		if err := rows.Scan(
+			&i.ID,
+			&i.SubscriptionID,
+			&i.UserID,
+			&i.LocationID,
+			&i.Metric,
+			&i.Operator,
+			&i.Threshold,
+			&i.Value,
+			&i.ForecastTime,
+			&i.Day,
+			&i.TriggeredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateAlertSubscription = `-- name: UpdateAlertSubscription :execrows
+UPDATE alert_subscriptions
+SET metric = $2, operator = $3, threshold = $4, period = $5
+WHERE id = $1
+`
+
+type UpdateAlertSubscriptionParams struct {
+	ID        uuid.UUID
+	Metric    string
+	Operator  string
+	Threshold float64
+	Period    string
+}
+
+func (q *Queries) UpdateAlertSubscription(ctx context.Context, arg UpdateAlertSubscriptionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateAlertSubscription,
+		arg.ID,
+		arg.Metric,
+		arg.Operator,
+		arg.Threshold,
+		arg.Period,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,198 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: locations.sql
+
+package gen
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const countLocationsByUser = `-- name: CountLocationsByUser :one
+SELECT COUNT(*)::int AS count, COALESCE(MAX(position) + 1, 0)::int AS next_position
+FROM locations
+WHERE user_id = $1
+`
+
+type CountLocationsByUserRow struct {
+	Count        int32
+	NextPosition int32
+}
+
+func (q *Queries) CountLocationsByUser(ctx context.Context, userID uuid.UUID) (CountLocationsByUserRow, error) {
+	row := q.db.QueryRow(ctx, countLocationsByUser, userID)
+	var i CountLocationsByUserRow
+	err := row.Scan(&i.Count, &i.NextPosition)
+	return i, err
+}
+
+const deleteLocation = `-- name: DeleteLocation :execrows
+DELETE FROM locations
+WHERE id = $1
+`
+
+func (q *Queries) DeleteLocation(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteLocation, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const insertLocation = `-- name: InsertLocation :one
+INSERT INTO locations (id, user_id, name, latitude, longitude, position, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, user_id, name, latitude, longitude, position, created_at
+`
+
+type InsertLocationParams struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Name      string
+	Latitude  float64
+	Longitude float64
+	Position  int32
+	CreatedAt time.Time
+}
+
+func (q *Queries) InsertLocation(ctx context.Context, arg InsertLocationParams) (Location, error) {
+	row := q.db.QueryRow(ctx, insertLocation,
+		arg.ID,
+		arg.UserID,
+		arg.Name,
+		arg.Latitude,
+		arg.Longitude,
+		arg.Position,
+		arg.CreatedAt,
+	)
+	var i Location
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.Latitude,
+		&i.Longitude,
+		&i.Position,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const lockUser = `-- name: LockUser :one
+SELECT id
+FROM users
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) LockUser(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, lockUser, id)
+	err := row.Scan(&id)
+	return id, err
+}
+
+const selectLocationById = `-- name: SelectLocationById :one
+SELECT id, user_id, name, latitude, longitude, position, created_at
+FROM locations
+WHERE id = $1
+`
+
+func (q *Queries) SelectLocationById(ctx context.Context, id uuid.UUID) (Location, error) {
+	row := q.db.QueryRow(ctx, selectLocationById, id)
+	var i Location
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.Latitude,
+		&i.Longitude,
+		&i.Position,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const selectLocationsByUser = `-- name: SelectLocationsByUser :many
+SELECT id, user_id, name, latitude, longitude, position, created_at
+FROM locations
+WHERE user_id = $1
+ORDER BY position, created_at
+`
+
+func (q *Queries) SelectLocationsByUser(ctx context.Context, userID uuid.UUID) ([]Location, error) {
+	rows, err := q.db.Query(ctx, selectLocationsByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Location
+	for rows.Next() {
+		var i Location
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.Latitude,
+			&i.Longitude,
+			&i.Position,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateLocation = `-- name: UpdateLocation :execrows
+UPDATE locations
+SET name = $2, latitude = $3, longitude = $4
+WHERE id = $1
+`
+
+type UpdateLocationParams struct {
+	ID        uuid.UUID
+	Name      string
+	Latitude  float64
+	Longitude float64
+}
+
+func (q *Queries) UpdateLocation(ctx context.Context, arg UpdateLocationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateLocation,
+		arg.ID,
+		arg.Name,
+		arg.Latitude,
+		arg.Longitude,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateLocationPosition = `-- name: UpdateLocationPosition :execrows
+UPDATE locations
+SET position = $3
+WHERE id = $1 AND user_id = $2
+`
+
+type UpdateLocationPositionParams struct {
+	ID       uuid.UUID
+	UserID   uuid.UUID
+	Position int32
+}
+
+func (q *Queries) UpdateLocationPosition(ctx context.Context, arg UpdateLocationPositionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateLocationPosition, arg.ID, arg.UserID, arg.Position)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
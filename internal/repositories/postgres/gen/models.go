@@ -5,11 +5,83 @@
 package gen
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
+type Alert struct {
+	ID             uuid.UUID
+	SubscriptionID uuid.UUID
+	UserID         uuid.UUID
+	LocationID     uuid.UUID
+	Metric         string
+	Operator       string
+	Threshold      float64
+	Value          float64
+	ForecastTime   time.Time
+	Day            time.Time
+	TriggeredAt    time.Time
+}
+
+type AlertSubscription struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	LocationID uuid.UUID
+	Metric     string
+	Operator   string
+	Threshold  float64
+	Period     string
+	CreatedAt  time.Time
+}
+
+type Location struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Name      string
+	Latitude  float64
+	Longitude float64
+	Position  int32
+	CreatedAt time.Time
+}
+
+type Notification struct {
+	ID                uuid.UUID
+	UserID            uuid.UUID
+	Kind              string
+	Title             string
+	Message           string
+	Data              []byte
+	CreatedAt         time.Time
+	Attempts          int32
+	DeliveredChannels []string
+	NextAttemptAt     time.Time
+	LastError         string
+	SentAt            *time.Time
+	FailedAt          *time.Time
+}
+
+type NotificationSetting struct {
+	UserID                     uuid.UUID
+	Email                      string
+	WebhookUrl                 string
+	WebhookSecret              string
+	EmailVerified              bool
+	EmailVerificationHash      string
+	EmailVerificationExpiresAt *time.Time
+}
+
 type User struct {
 	ID       uuid.UUID
 	Login    string
 	Password string
 }
+
+type UserPreference struct {
+	UserID        uuid.UUID
+	Units         string
+	WindSpeedUnit string
+	TimeZone      string
+	Language      string
+	Clock         string
+}
@@ -0,0 +1,255 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: notifications.sql
+
+package gen
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const addNotificationDeliveredChannel = `-- name: AddNotificationDeliveredChannel :execrows
+UPDATE notifications
+SET delivered_channels = array_append(delivered_channels, $1::text)
+WHERE id = $2 AND NOT ($1::text = ANY (delivered_channels))
+`
+
+type AddNotificationDeliveredChannelParams struct {
+	Channel string
+	ID      uuid.UUID
+}
+
+func (q *Queries) AddNotificationDeliveredChannel(ctx context.Context, arg AddNotificationDeliveredChannelParams) (int64, error) {
+	result, err := q.db.Exec(ctx, addNotificationDeliveredChannel, arg.Channel, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const claimNotifications = `-- name: ClaimNotifications :many
+UPDATE notifications
+SET attempts = attempts + 1, next_attempt_at = $1
+WHERE id IN (
+    SELECT pending.id
+    FROM notifications pending
+    WHERE pending.sent_at IS NULL AND pending.failed_at IS NULL AND pending.next_attempt_at <= $2
+    ORDER BY pending.next_attempt_at
+    LIMIT $3
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, user_id, kind, title, message, data, created_at, attempts, delivered_channels
+`
+
+type ClaimNotificationsParams struct {
+	LeaseUntil time.Time
+	Now        time.Time
+	MaxCount   int32
+}
+
+type ClaimNotificationsRow struct {
+	ID                uuid.UUID
+	UserID            uuid.UUID
+	Kind              string
+	Title             string
+	Message           string
+	Data              []byte
+	CreatedAt         time.Time
+	Attempts          int32
+	DeliveredChannels []string
+}
+
+func (q *Queries) ClaimNotifications(ctx context.Context, arg ClaimNotificationsParams) ([]ClaimNotificationsRow, error) {
+	rows, err := q.db.Query(ctx, claimNotifications, arg.LeaseUntil, arg.Now, arg.MaxCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ClaimNotificationsRow
+	for rows.Next() {
+		var i ClaimNotificationsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Kind,
+			&i.Title,
+			&i.Message,
+			&i.Data,
+			&i.CreatedAt,
+			&i.Attempts,
+			&i.DeliveredChannels,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const completeNotification = `-- name: CompleteNotification :execrows
+UPDATE notifications
+SET sent_at = $2, last_error = '',
+    message = CASE WHEN kind = 'email_verification' THEN '' ELSE message END
+WHERE id = $1
+`
+
+type CompleteNotificationParams struct {
+	ID     uuid.UUID
+	SentAt *time.Time
+}
+
+// The message of the email verification contains the code, so it isn't kept after the delivery.
+func (q *Queries) CompleteNotification(ctx context.Context, arg CompleteNotificationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, completeNotification, arg.ID, arg.SentAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const failNotification = `-- name: FailNotification :execrows
+UPDATE notifications
+SET failed_at = $2, last_error = $3,
+    message = CASE WHEN kind = 'email_verification' THEN '' ELSE message END
+WHERE id = $1
+`
+
+type FailNotificationParams struct {
+	ID        uuid.UUID
+	FailedAt  *time.Time
+	LastError string
+}
+
+// The message of the email verification contains the code, so it isn't kept after the delivery.
+func (q *Queries) FailNotification(ctx context.Context, arg FailNotificationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, failNotification, arg.ID, arg.FailedAt, arg.LastError)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const insertNotification = `-- name: InsertNotification :exec
+INSERT INTO notifications (id, user_id, kind, title, message, data, created_at, next_attempt_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $7)
+`
+
+type InsertNotificationParams struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Kind      string
+	Title     string
+	Message   string
+	Data      []byte
+	CreatedAt time.Time
+}
+
+func (q *Queries) InsertNotification(ctx context.Context, arg InsertNotificationParams) error {
+	_, err := q.db.Exec(ctx, insertNotification,
+		arg.ID,
+		arg.UserID,
+		arg.Kind,
+		arg.Title,
+		arg.Message,
+		arg.Data,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const retryNotification = `-- name: RetryNotification :execrows
+UPDATE notifications
+SET next_attempt_at = $2, last_error = $3
+WHERE id = $1
+`
+
+type RetryNotificationParams struct {
+	ID            uuid.UUID
+	NextAttemptAt time.Time
+	LastError     string
+}
+
+func (q *Queries) RetryNotification(ctx context.Context, arg RetryNotificationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, retryNotification, arg.ID, arg.NextAttemptAt, arg.LastError)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const selectNotificationSettings = `-- name: SelectNotificationSettings :one
+SELECT user_id, email, email_verified, email_verification_hash, email_verification_expires_at,
+    webhook_url, webhook_secret
+FROM notification_settings
+WHERE user_id = $1
+`
+
+type SelectNotificationSettingsRow struct {
+	UserID                     uuid.UUID
+	Email                      string
+	EmailVerified              bool
+	EmailVerificationHash      string
+	EmailVerificationExpiresAt *time.Time
+	WebhookUrl                 string
+	WebhookSecret              string
+}
+
+func (q *Queries) SelectNotificationSettings(ctx context.Context, userID uuid.UUID) (SelectNotificationSettingsRow, error) {
+	row := q.db.QueryRow(ctx, selectNotificationSettings, userID)
+	var i SelectNotificationSettingsRow
+	err := row.Scan(
+		&i.UserID,
+		&i.Email,
+		&i.EmailVerified,
+		&i.EmailVerificationHash,
+		&i.EmailVerificationExpiresAt,
+		&i.WebhookUrl,
+		&i.WebhookSecret,
+	)
+	return i, err
+}
+
+const upsertNotificationSettings = `-- name: UpsertNotificationSettings :exec
+INSERT INTO notification_settings (
+    user_id, email, email_verified, email_verification_hash, email_verification_expires_at,
+    webhook_url, webhook_secret
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (user_id) DO UPDATE
+SET email = EXCLUDED.email,
+    email_verified = EXCLUDED.email_verified,
+    email_verification_hash = EXCLUDED.email_verification_hash,
+    email_verification_expires_at = EXCLUDED.email_verification_expires_at,
+    webhook_url = EXCLUDED.webhook_url,
+    webhook_secret = EXCLUDED.webhook_secret
+`
+
+type UpsertNotificationSettingsParams struct {
+	UserID                     uuid.UUID
+	Email                      string
+	EmailVerified              bool
+	EmailVerificationHash      string
+	EmailVerificationExpiresAt *time.Time
+	WebhookUrl                 string
+	WebhookSecret              string
+}
+
+func (q *Queries) UpsertNotificationSettings(ctx context.Context, arg UpsertNotificationSettingsParams) error {
+	_, err := q.db.Exec(ctx, upsertNotificationSettings,
+		arg.UserID,
+		arg.Email,
+		arg.EmailVerified,
+		arg.EmailVerificationHash,
+		arg.EmailVerificationExpiresAt,
+		arg.WebhookUrl,
+		arg.WebhookSecret,
+	)
+	return err
+}
@@ -55,3 +55,74 @@ func (q *Queries) SelectUserByLogin(ctx context.Context, login string) (User, er
 	err := row.Scan(&i.ID, &i.Login, &i.Password)
 	return i, err
 }
+
+const selectUserPreferences = `-- name: SelectUserPreferences :one
+SELECT user_id, units, wind_speed_unit, time_zone, language, clock
+FROM user_preferences
+WHERE user_id = $1
+`
+
+func (q *Queries) SelectUserPreferences(ctx context.Context, userID uuid.UUID) (UserPreference, error) {
+	row := q.db.QueryRow(ctx, selectUserPreferences, userID)
+	var i UserPreference
+	err := row.Scan(
+		&i.UserID,
+		&i.Units,
+		&i.WindSpeedUnit,
+		&i.TimeZone,
+		&i.Language,
+		&i.Clock,
+	)
+	return i, err
+}
+
+const updateUserPassword = `-- name: UpdateUserPassword :execrows
+UPDATE users
+SET password = $2
+WHERE id = $1
+`
+
+type UpdateUserPasswordParams struct {
+	ID       uuid.UUID
+	Password string
+}
+
+func (q *Queries) UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateUserPassword, arg.ID, arg.Password)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const upsertUserPreferences = `-- name: UpsertUserPreferences :exec
+INSERT INTO user_preferences (user_id, units, wind_speed_unit, time_zone, language, clock)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (user_id) DO UPDATE
+SET units = EXCLUDED.units,
+    wind_speed_unit = EXCLUDED.wind_speed_unit,
+    time_zone = EXCLUDED.time_zone,
+    language = EXCLUDED.language,
+    clock = EXCLUDED.clock
+`
+
+type UpsertUserPreferencesParams struct {
+	UserID        uuid.UUID
+	Units         string
+	WindSpeedUnit string
+	TimeZone      string
+	Language      string
+	Clock         string
+}
+
+func (q *Queries) UpsertUserPreferences(ctx context.Context, arg UpsertUserPreferencesParams) error {
+	_, err := q.db.Exec(ctx, upsertUserPreferences,
+		arg.UserID,
+		arg.Units,
+		arg.WindSpeedUnit,
+		arg.TimeZone,
+		arg.Language,
+		arg.Clock,
+	)
+	return err
+}
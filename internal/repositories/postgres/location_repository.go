@@ -0,0 +1,230 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+	"github.com/maxdikun/weatherapp/internal/repositories"
+	"github.com/maxdikun/weatherapp/internal/repositories/postgres/gen"
+)
+
+type LocationRepository struct {
+	pool *pgxpool.Pool
+}
+
+var _ repositories.LocationRepository = (*LocationRepository)(nil)
+
+// Add implements repositories.LocationRepository.
+func (l *LocationRepository) Add(ctx context.Context, location models.Location, limit int) (models.Location, error) {
+	tx, err := l.pool.Begin(ctx)
+	if err != nil {
+		return models.Location{}, fmt.Errorf("postgres.LocationRepository.Add: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	queries := gen.New(tx)
+
+	// The user row serializes concurrent additions, so the limit can't be exceeded by a race.
+	if _, err := queries.LockUser(ctx, location.User); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Location{}, &repositories.NotFoundError{
+				Object: "user",
+				Field:  "id",
+			}
+		}
+		return models.Location{}, fmt.Errorf("postgres.LocationRepository.Add: %w", err)
+	}
+
+	stats, err := queries.CountLocationsByUser(ctx, location.User)
+	if err != nil {
+		return models.Location{}, fmt.Errorf("postgres.LocationRepository.Add: %w", err)
+	}
+	if int(stats.Count) >= limit {
+		return models.Location{}, &repositories.LimitExceededError{
+			Object: "location",
+			Limit:  limit,
+		}
+	}
+
+	result, err := queries.InsertLocation(ctx, gen.InsertLocationParams{
+		ID:        location.Id,
+		UserID:    location.User,
+		Name:      location.Name,
+		Latitude:  location.Coordinates.Latitude,
+		Longitude: location.Coordinates.Longitude,
+		Position:  stats.NextPosition,
+		CreatedAt: location.CreatedAt,
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return models.Location{}, &repositories.AlreadyExistsError{
+				Object: "location",
+				Field:  "name",
+			}
+		}
+		return models.Location{}, fmt.Errorf("postgres.LocationRepository.Add: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return models.Location{}, fmt.Errorf("postgres.LocationRepository.Add: %w", err)
+	}
+
+	return toLocationModel(result), nil
+}
+
+// Delete implements repositories.LocationRepository.
+func (l *LocationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	queries := gen.New(l.pool)
+
+	rows, err := queries.DeleteLocation(ctx, id)
+	if err != nil {
+		return fmt.Errorf("postgres.LocationRepository.Delete: %w", err)
+	}
+
+	if rows == 0 {
+		return &repositories.NotFoundError{
+			Object: "location",
+			Field:  "id",
+		}
+	}
+
+	return nil
+}
+
+// FindById implements repositories.LocationRepository.
+func (l *LocationRepository) FindById(ctx context.Context, id uuid.UUID) (models.Location, error) {
+	queries := gen.New(l.pool)
+
+	result, err := queries.SelectLocationById(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Location{}, &repositories.NotFoundError{
+				Object: "location",
+				Field:  "id",
+			}
+		}
+
+		return models.Location{}, fmt.Errorf("postgres.LocationRepository.FindById: %w", err)
+	}
+
+	return toLocationModel(result), nil
+}
+
+// FindByUser implements repositories.LocationRepository.
+func (l *LocationRepository) FindByUser(ctx context.Context, user uuid.UUID) ([]models.Location, error) {
+	queries := gen.New(l.pool)
+
+	results, err := queries.SelectLocationsByUser(ctx, user)
+	if err != nil {
+		return nil, fmt.Errorf("postgres.LocationRepository.FindByUser: %w", err)
+	}
+
+	locations := make([]models.Location, len(results))
+	for i, result := range results {
+		locations[i] = toLocationModel(result)
+	}
+
+	return locations, nil
+}
+
+// Reorder implements repositories.LocationRepository.
+func (l *LocationRepository) Reorder(ctx context.Context, user uuid.UUID, ids []uuid.UUID) error {
+	tx, err := l.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("postgres.LocationRepository.Reorder: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	queries := gen.New(tx)
+
+	for i, id := range ids {
+		rows, err := queries.UpdateLocationPosition(ctx, gen.UpdateLocationPositionParams{
+			ID:       id,
+			UserID:   user,
+			Position: int32(i),
+		})
+		if err != nil {
+			return fmt.Errorf("postgres.LocationRepository.Reorder: %w", err)
+		}
+
+		if rows == 0 {
+			return &repositories.NotFoundError{
+				Object: "location",
+				Field:  "id",
+			}
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("postgres.LocationRepository.Reorder: %w", err)
+	}
+
+	return nil
+}
+
+// Update implements repositories.LocationRepository.
+func (l *LocationRepository) Update(ctx context.Context, location models.Location) error {
+	queries := gen.New(l.pool)
+
+	rows, err := queries.UpdateLocation(ctx, gen.UpdateLocationParams{
+		ID:        location.Id,
+		Name:      location.Name,
+		Latitude:  location.Coordinates.Latitude,
+		Longitude: location.Coordinates.Longitude,
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return &repositories.AlreadyExistsError{
+				Object: "location",
+				Field:  "name",
+			}
+		}
+		return fmt.Errorf("postgres.LocationRepository.Update: %w", err)
+	}
+
+	if rows == 0 {
+		return &repositories.NotFoundError{
+			Object: "location",
+			Field:  "id",
+		}
+	}
+
+	return nil
+}
+
+func toLocationModel(location gen.Location) models.Location {
+	return models.Location{
+		Id:   location.ID,
+		User: location.UserID,
+		Name: location.Name,
+		Coordinates: models.Coordinates{
+			Latitude:  location.Latitude,
+			Longitude: location.Longitude,
+		},
+		Position:  int(location.Position),
+		CreatedAt: location.CreatedAt,
+	}
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
+
+func isForeignKeyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23503"
+}
+
+func NewLocationRepository(pool *pgxpool.Pool) *LocationRepository {
+	return &LocationRepository{
+		pool: pool,
+	}
+}
@@ -0,0 +1,224 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+	"github.com/maxdikun/weatherapp/internal/repositories"
+	"github.com/maxdikun/weatherapp/internal/repositories/postgres/gen"
+)
+
+type NotificationRepository struct {
+	pool *pgxpool.Pool
+}
+
+var _ repositories.NotificationRepository = (*NotificationRepository)(nil)
+
+// Add implements repositories.NotificationRepository.
+func (n *NotificationRepository) Add(ctx context.Context, notification models.Notification) error {
+	if err := insertNotification(ctx, gen.New(n.pool), notification); err != nil {
+		return fmt.Errorf("postgres.NotificationRepository.Add: %w", err)
+	}
+	return nil
+}
+
+// Claim implements repositories.NotificationRepository.
+func (n *NotificationRepository) Claim(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]models.Notification, error) {
+	queries := gen.New(n.pool)
+
+	results, err := queries.ClaimNotifications(ctx, gen.ClaimNotificationsParams{
+		LeaseUntil: leaseUntil,
+		Now:        now,
+		MaxCount:   int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("postgres.NotificationRepository.Claim: %w", err)
+	}
+
+	notifications := make([]models.Notification, len(results))
+	for i, result := range results {
+		var data map[string]any
+		if err := json.Unmarshal(result.Data, &data); err != nil {
+			return nil, fmt.Errorf("postgres.NotificationRepository.Claim: %w", err)
+		}
+
+		notifications[i] = models.Notification{
+			Id:        result.ID,
+			User:      result.UserID,
+			Kind:      models.NotificationKind(result.Kind),
+			Title:     result.Title,
+			Message:   result.Message,
+			Data:      data,
+			CreatedAt: result.CreatedAt,
+			Attempts:  int(result.Attempts),
+			Delivered: result.DeliveredChannels,
+		}
+	}
+
+	return notifications, nil
+}
+
+// MarkDelivered implements repositories.NotificationRepository.
+func (n *NotificationRepository) MarkDelivered(ctx context.Context, id uuid.UUID, channel string) error {
+	queries := gen.New(n.pool)
+
+	if _, err := queries.AddNotificationDeliveredChannel(ctx, gen.AddNotificationDeliveredChannelParams{
+		ID:      id,
+		Channel: channel,
+	}); err != nil {
+		return fmt.Errorf("postgres.NotificationRepository.MarkDelivered: %w", err)
+	}
+
+	return nil
+}
+
+// Complete implements repositories.NotificationRepository.
+func (n *NotificationRepository) Complete(ctx context.Context, id uuid.UUID, at time.Time) error {
+	queries := gen.New(n.pool)
+
+	rows, err := queries.CompleteNotification(ctx, gen.CompleteNotificationParams{
+		ID:     id,
+		SentAt: &at,
+	})
+	if err != nil {
+		return fmt.Errorf("postgres.NotificationRepository.Complete: %w", err)
+	}
+
+	return notificationRowsError(rows)
+}
+
+// Retry implements repositories.NotificationRepository.
+func (n *NotificationRepository) Retry(ctx context.Context, id uuid.UUID, at time.Time, lastError string) error {
+	queries := gen.New(n.pool)
+
+	rows, err := queries.RetryNotification(ctx, gen.RetryNotificationParams{
+		ID:            id,
+		NextAttemptAt: at,
+		LastError:     lastError,
+	})
+	if err != nil {
+		return fmt.Errorf("postgres.NotificationRepository.Retry: %w", err)
+	}
+
+	return notificationRowsError(rows)
+}
+
+// Fail implements repositories.NotificationRepository.
+func (n *NotificationRepository) Fail(ctx context.Context, id uuid.UUID, at time.Time, lastError string) error {
+	queries := gen.New(n.pool)
+
+	rows, err := queries.FailNotification(ctx, gen.FailNotificationParams{
+		ID:        id,
+		FailedAt:  &at,
+		LastError: lastError,
+	})
+	if err != nil {
+		return fmt.Errorf("postgres.NotificationRepository.Fail: %w", err)
+	}
+
+	return notificationRowsError(rows)
+}
+
+// FindSettings implements repositories.NotificationRepository.
+func (n *NotificationRepository) FindSettings(ctx context.Context, user uuid.UUID) (models.NotificationSettings, error) {
+	queries := gen.New(n.pool)
+
+	result, err := queries.SelectNotificationSettings(ctx, user)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.NotificationSettings{}, &repositories.NotFoundError{
+				Object: "notification settings",
+				Field:  "user",
+			}
+		}
+
+		return models.NotificationSettings{}, fmt.Errorf("postgres.NotificationRepository.FindSettings: %w", err)
+	}
+
+	settings := models.NotificationSettings{
+		User:                  result.UserID,
+		Email:                 result.Email,
+		EmailVerified:         result.EmailVerified,
+		EmailVerificationHash: result.EmailVerificationHash,
+		WebhookURL:            result.WebhookUrl,
+		WebhookSecret:         result.WebhookSecret,
+	}
+	if result.EmailVerificationExpiresAt != nil {
+		settings.EmailVerificationExpiresAt = *result.EmailVerificationExpiresAt
+	}
+	return settings, nil
+}
+
+// SaveSettings implements repositories.NotificationRepository.
+func (n *NotificationRepository) SaveSettings(ctx context.Context, settings models.NotificationSettings) error {
+	queries := gen.New(n.pool)
+
+	var verificationExpiresAt *time.Time
+	if !settings.EmailVerificationExpiresAt.IsZero() {
+		verificationExpiresAt = &settings.EmailVerificationExpiresAt
+	}
+
+	err := queries.UpsertNotificationSettings(ctx, gen.UpsertNotificationSettingsParams{
+		UserID:                     settings.User,
+		Email:                      settings.Email,
+		EmailVerified:              settings.EmailVerified,
+		EmailVerificationHash:      settings.EmailVerificationHash,
+		EmailVerificationExpiresAt: verificationExpiresAt,
+		WebhookUrl:                 settings.WebhookURL,
+		WebhookSecret:              settings.WebhookSecret,
+	})
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return &repositories.NotFoundError{
+				Object: "user",
+				Field:  "id",
+			}
+		}
+		return fmt.Errorf("postgres.NotificationRepository.SaveSettings: %w", err)
+	}
+
+	return nil
+}
+
+// insertNotification adds the notification to the outbox, queries can belong to the transaction
+// of the event, so the notification is stored only together with it.
+func insertNotification(ctx context.Context, queries *gen.Queries, notification models.Notification) error {
+	data, err := json.Marshal(notification.Data)
+	if err != nil {
+		return err
+	}
+
+	return queries.InsertNotification(ctx, gen.InsertNotificationParams{
+		ID:        notification.Id,
+		UserID:    notification.User,
+		Kind:      string(notification.Kind),
+		Title:     notification.Title,
+		Message:   notification.Message,
+		Data:      data,
+		CreatedAt: notification.CreatedAt,
+	})
+}
+
+func notificationRowsError(rows int64) error {
+	if rows == 0 {
+		return &repositories.NotFoundError{
+			Object: "notification",
+			Field:  "id",
+		}
+	}
+	return nil
+}
+
+func NewNotificationRepository(pool *pgxpool.Pool) *NotificationRepository {
+	return &NotificationRepository{
+		pool: pool,
+	}
+}
@@ -0,0 +1,50 @@
+-- name: SelectAlertSubscriptionById :one
+SELECT id, user_id, location_id, metric, operator, threshold, period, created_at
+FROM alert_subscriptions
+WHERE id = $1;
+
+-- name: SelectAlertSubscriptionsByUser :many
+SELECT id, user_id, location_id, metric, operator, threshold, period, created_at
+FROM alert_subscriptions
+WHERE user_id = $1
+ORDER BY created_at, id;
+
+-- name: SelectAlertTargets :many
+SELECT s.id, s.user_id, s.location_id, s.metric, s.operator, s.threshold, s.period, s.created_at,
+       l.name AS location_name, l.latitude, l.longitude, COALESCE(p.time_zone, 'UTC')::text AS time_zone
+FROM alert_subscriptions s
+JOIN locations l ON l.id = s.location_id
+LEFT JOIN user_preferences p ON p.user_id = s.user_id
+ORDER BY s.location_id;
+
+-- name: CountAlertSubscriptionsByUser :one
+SELECT COUNT(*)::int
+FROM alert_subscriptions
+WHERE user_id = $1;
+
+-- name: InsertAlertSubscription :exec
+INSERT INTO alert_subscriptions (id, user_id, location_id, metric, operator, threshold, period, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: UpdateAlertSubscription :execrows
+UPDATE alert_subscriptions
+SET metric = $2, operator = $3, threshold = $4, period = $5
+WHERE id = $1;
+
+-- name: DeleteAlertSubscription :execrows
+DELETE FROM alert_subscriptions
+WHERE id = $1;
+
+-- name: InsertAlert :execrows
+INSERT INTO alerts (
+    id, subscription_id, user_id, location_id, metric, operator, threshold, value, forecast_time, day, triggered_at
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+ON CONFLICT (subscription_id, day) DO NOTHING;
+
+-- name: SelectAlertsByUser :many
+SELECT id, subscription_id, user_id, location_id, metric, operator, threshold, value, forecast_time, day, triggered_at
+FROM alerts
+WHERE user_id = $1
+ORDER BY triggered_at DESC, id
+LIMIT $2;
@@ -0,0 +1,40 @@
+-- name: SelectLocationById :one
+SELECT id, user_id, name, latitude, longitude, position, created_at
+FROM locations
+WHERE id = $1;
+
+-- name: SelectLocationsByUser :many
+SELECT id, user_id, name, latitude, longitude, position, created_at
+FROM locations
+WHERE user_id = $1
+ORDER BY position, created_at;
+
+-- name: LockUser :one
+SELECT id
+FROM users
+WHERE id = $1
+FOR UPDATE;
+
+-- name: CountLocationsByUser :one
+SELECT COUNT(*)::int AS count, COALESCE(MAX(position) + 1, 0)::int AS next_position
+FROM locations
+WHERE user_id = $1;
+
+-- name: InsertLocation :one
+INSERT INTO locations (id, user_id, name, latitude, longitude, position, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: UpdateLocation :execrows
+UPDATE locations
+SET name = $2, latitude = $3, longitude = $4
+WHERE id = $1;
+
+-- name: UpdateLocationPosition :execrows
+UPDATE locations
+SET position = $3
+WHERE id = $1 AND user_id = $2;
+
+-- name: DeleteLocation :execrows
+DELETE FROM locations
+WHERE id = $1;
@@ -0,0 +1,60 @@
+-- name: InsertNotification :exec
+INSERT INTO notifications (id, user_id, kind, title, message, data, created_at, next_attempt_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $7);
+
+-- name: ClaimNotifications :many
+UPDATE notifications
+SET attempts = attempts + 1, next_attempt_at = @lease_until
+WHERE id IN (
+    SELECT pending.id
+    FROM notifications pending
+    WHERE pending.sent_at IS NULL AND pending.failed_at IS NULL AND pending.next_attempt_at <= @now
+    ORDER BY pending.next_attempt_at
+    LIMIT @max_count
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, user_id, kind, title, message, data, created_at, attempts, delivered_channels;
+
+-- name: AddNotificationDeliveredChannel :execrows
+UPDATE notifications
+SET delivered_channels = array_append(delivered_channels, @channel::text)
+WHERE id = @id AND NOT (@channel::text = ANY (delivered_channels));
+
+-- name: CompleteNotification :execrows
+-- The message of the email verification contains the code, so it isn't kept after the delivery.
+UPDATE notifications
+SET sent_at = $2, last_error = '',
+    message = CASE WHEN kind = 'email_verification' THEN '' ELSE message END
+WHERE id = $1;
+
+-- name: RetryNotification :execrows
+UPDATE notifications
+SET next_attempt_at = $2, last_error = $3
+WHERE id = $1;
+
+-- name: FailNotification :execrows
+-- The message of the email verification contains the code, so it isn't kept after the delivery.
+UPDATE notifications
+SET failed_at = $2, last_error = $3,
+    message = CASE WHEN kind = 'email_verification' THEN '' ELSE message END
+WHERE id = $1;
+
+-- name: SelectNotificationSettings :one
+SELECT user_id, email, email_verified, email_verification_hash, email_verification_expires_at,
+    webhook_url, webhook_secret
+FROM notification_settings
+WHERE user_id = $1;
+
+-- name: UpsertNotificationSettings :exec
+INSERT INTO notification_settings (
+    user_id, email, email_verified, email_verification_hash, email_verification_expires_at,
+    webhook_url, webhook_secret
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (user_id) DO UPDATE
+SET email = EXCLUDED.email,
+    email_verified = EXCLUDED.email_verified,
+    email_verification_hash = EXCLUDED.email_verification_hash,
+    email_verification_expires_at = EXCLUDED.email_verification_expires_at,
+    webhook_url = EXCLUDED.webhook_url,
+    webhook_secret = EXCLUDED.webhook_secret;
@@ -11,4 +11,24 @@ WHERE login = $1;
 -- name: InsertUser :one
 INSERT INTO users (id, login, password)
 VALUES ($1, $2, $3)
-RETURNING *;
\ No newline at end of file
+RETURNING *;
+
+-- name: UpdateUserPassword :execrows
+UPDATE users
+SET password = $2
+WHERE id = $1;
+
+-- name: SelectUserPreferences :one
+SELECT user_id, units, wind_speed_unit, time_zone, language, clock
+FROM user_preferences
+WHERE user_id = $1;
+
+-- name: UpsertUserPreferences :exec
+INSERT INTO user_preferences (user_id, units, wind_speed_unit, time_zone, language, clock)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (user_id) DO UPDATE
+SET units = EXCLUDED.units,
+    wind_speed_unit = EXCLUDED.wind_speed_unit,
+    time_zone = EXCLUDED.time_zone,
+    language = EXCLUDED.language,
+    clock = EXCLUDED.clock;
@@ -92,6 +92,117 @@ func (u *UserRepository) FindByLogin(ctx context.Context, login string) (models.
 	}, nil
 }
 
+// UpdatePassword implements repositories.UserRepository.
+func (u *UserRepository) UpdatePassword(ctx context.Context, id uuid.UUID, password string) error {
+	queries := gen.New(u.pool)
+
+	rows, err := queries.UpdateUserPassword(ctx, gen.UpdateUserPasswordParams{
+		ID:       id,
+		Password: password,
+	})
+	if err != nil {
+		return fmt.Errorf("postgres.UserRepository.UpdatePassword: %w", err)
+	}
+
+	if rows == 0 {
+		return &repositories.NotFoundError{
+			Object: "user",
+			Field:  "id",
+		}
+	}
+
+	return nil
+}
+
+// ChangePassword implements repositories.UserRepository.
+func (u *UserRepository) ChangePassword(ctx context.Context, id uuid.UUID, password string, notification models.Notification) error {
+	tx, err := u.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("postgres.UserRepository.ChangePassword: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	queries := gen.New(tx)
+
+	rows, err := queries.UpdateUserPassword(ctx, gen.UpdateUserPasswordParams{
+		ID:       id,
+		Password: password,
+	})
+	if err != nil {
+		return fmt.Errorf("postgres.UserRepository.ChangePassword: %w", err)
+	}
+
+	if rows == 0 {
+		return &repositories.NotFoundError{
+			Object: "user",
+			Field:  "id",
+		}
+	}
+
+	if err := insertNotification(ctx, queries, notification); err != nil {
+		return fmt.Errorf("postgres.UserRepository.ChangePassword: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("postgres.UserRepository.ChangePassword: %w", err)
+	}
+
+	return nil
+}
+
+// FindPreferences implements repositories.UserRepository.
+func (u *UserRepository) FindPreferences(ctx context.Context, user uuid.UUID) (models.Preferences, error) {
+	queries := gen.New(u.pool)
+
+	result, err := queries.SelectUserPreferences(ctx, user)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Preferences{}, &repositories.NotFoundError{
+				Object: "preferences",
+				Field:  "user",
+			}
+		}
+
+		return models.Preferences{}, fmt.Errorf("postgres.UserRepository.FindPreferences: %w", err)
+	}
+
+	return models.Preferences{
+		User: result.UserID,
+		Units: models.Units{
+			System:    models.UnitSystem(result.Units),
+			WindSpeed: models.WindSpeedUnit(result.WindSpeedUnit),
+		},
+		TimeZone: result.TimeZone,
+		Language: result.Language,
+		Clock:    models.ClockFormat(result.Clock),
+	}, nil
+}
+
+// SavePreferences implements repositories.UserRepository.
+func (u *UserRepository) SavePreferences(ctx context.Context, preferences models.Preferences) error {
+	queries := gen.New(u.pool)
+
+	err := queries.UpsertUserPreferences(ctx, gen.UpsertUserPreferencesParams{
+		UserID:        preferences.User,
+		Units:         string(preferences.Units.System),
+		WindSpeedUnit: string(preferences.Units.WindSpeed),
+		TimeZone:      preferences.TimeZone,
+		Language:      preferences.Language,
+		Clock:         string(preferences.Clock),
+	})
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return &repositories.NotFoundError{
+				Object: "user",
+				Field:  "id",
+			}
+		}
+		return fmt.Errorf("postgres.UserRepository.SavePreferences: %w", err)
+	}
+
+	return nil
+}
+
 func NewUserRepository(pool *pgxpool.Pool) *UserRepository {
 	return &UserRepository{
 		pool: pool,
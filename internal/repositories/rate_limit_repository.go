@@ -0,0 +1,31 @@
+package repositories
+
+import (
+	"context"
+	"time"
+)
+
+// TokenBucket is stored by the Key and refilled with Rate tokens per second up to Burst tokens.
+type TokenBucket struct {
+	Key   string
+	Rate  float64
+	Burst int
+}
+
+// BucketState is the state of the bucket after an attempt to take a token.
+type BucketState struct {
+	// Allowed reports whether the bucket had a token, it's taken only if every bucket had one.
+	Allowed   bool
+	Remaining int
+	// RetryAfter is the time until the next token is available, zero if the attempt was allowed.
+	RetryAfter time.Duration
+	// Reset is the time until the bucket is full again.
+	Reset time.Duration
+}
+
+// RateLimitRepository keeps token buckets, which are shared by every replica of the app.
+type RateLimitRepository interface {
+	// Take atomically takes one token from every bucket, if all of them have one, and returns their states
+	// in the same order. Nothing is taken otherwise, so a denied request doesn't spend the other budgets.
+	Take(ctx context.Context, buckets []TokenBucket) ([]BucketState, error)
+}
@@ -0,0 +1,71 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/maxdikun/weatherapp/internal/repositories"
+)
+
+type CacheRepository struct {
+	client *redis.Client
+}
+
+var _ repositories.CacheRepository = (*CacheRepository)(nil)
+
+// Get implements repositories.CacheRepository.
+func (c *CacheRepository) Get(ctx context.Context, key string) (repositories.CacheEntry, error) {
+	values, err := c.client.HMGet(ctx, fmt.Sprintf("cache:%s", key), "value", "stale_at").Result()
+	if err != nil {
+		return repositories.CacheEntry{}, fmt.Errorf("redis.CacheRepository.Get: %w", err)
+	}
+
+	value, ok := values[0].(string)
+	if !ok {
+		return repositories.CacheEntry{}, &repositories.NotFoundError{
+			Object: "cache entry",
+			Field:  "key",
+		}
+	}
+
+	staleAt, _ := values[1].(string)
+	staleAtMs, err := strconv.ParseInt(staleAt, 10, 64)
+	if err != nil {
+		return repositories.CacheEntry{}, fmt.Errorf("redis.CacheRepository.Get: %w", err)
+	}
+
+	return repositories.CacheEntry{
+		Value:   []byte(value),
+		StaleAt: time.UnixMilli(staleAtMs),
+	}, nil
+}
+
+// Set implements repositories.CacheRepository.
+func (c *CacheRepository) Set(ctx context.Context, key string, entry repositories.CacheEntry, ttl time.Duration) error {
+	pipe := c.client.TxPipeline()
+	pipe.HSet(ctx, fmt.Sprintf("cache:%s", key), "value", entry.Value, "stale_at", entry.StaleAt.UnixMilli())
+	pipe.Expire(ctx, fmt.Sprintf("cache:%s", key), ttl)
+
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("redis.CacheRepository.Set: %w", err)
+	}
+	return nil
+}
+
+// TryLock implements repositories.CacheRepository.
+func (c *CacheRepository) TryLock(ctx context.Context, key string, duration time.Duration) (bool, error) {
+	locked, err := c.client.SetNX(ctx, fmt.Sprintf("cache_locks:%s", key), 1, duration).Result()
+	if err != nil {
+		return false, fmt.Errorf("redis.CacheRepository.TryLock: %w", err)
+	}
+	return locked, nil
+}
+
+func NewCacheRepository(client *redis.Client) *CacheRepository {
+	return &CacheRepository{client: client}
+}
@@ -0,0 +1,74 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+	"github.com/maxdikun/weatherapp/internal/repositories"
+)
+
+const eventsChannel = "events"
+
+// EventRepository uses Redis pub/sub, every replica receives the events through its own subscription.
+type EventRepository struct {
+	logger *slog.Logger
+	client *redis.Client
+}
+
+var _ repositories.EventRepository = (*EventRepository)(nil)
+
+// Publish implements repositories.EventRepository.
+func (e *EventRepository) Publish(ctx context.Context, event models.Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("redis.EventRepository.Publish: %w", err)
+	}
+
+	if err := e.client.Publish(ctx, eventsChannel, data).Err(); err != nil {
+		return fmt.Errorf("redis.EventRepository.Publish: %w", err)
+	}
+	return nil
+}
+
+// Subscribe implements repositories.EventRepository.
+func (e *EventRepository) Subscribe(ctx context.Context, handle func(event models.Event)) error {
+	pubsub := e.client.Subscribe(ctx, eventsChannel)
+	// The first reply confirms the subscription, afterwards the connection is restored automatically.
+	if _, err := pubsub.Receive(ctx); err != nil {
+		pubsub.Close()
+		return fmt.Errorf("redis.EventRepository.Subscribe: %w", err)
+	}
+
+	go func() {
+		defer pubsub.Close()
+
+		messages := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case message := <-messages:
+				var event models.Event
+				if err := json.Unmarshal([]byte(message.Payload), &event); err != nil {
+					e.logger.Warn("Event is malformed", "err", err)
+					continue
+				}
+				handle(event)
+			}
+		}
+	}()
+
+	return nil
+}
+
+func NewEventRepository(logger *slog.Logger, client *redis.Client) *EventRepository {
+	return &EventRepository{
+		logger: logger,
+		client: client,
+	}
+}
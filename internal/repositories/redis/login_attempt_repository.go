@@ -0,0 +1,107 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/maxdikun/weatherapp/internal/repositories"
+)
+
+// attemptScript counts the attempt for every key, unless any of them is locked out.
+// KEYS are pairs of the counter and the lock of every key, ARGV are the window, the lockout
+// and the maximum lockout in milliseconds, followed by the limits of the keys.
+var attemptScript = redis.NewScript(`
+local window = tonumber(ARGV[1])
+local lockout = tonumber(ARGV[2])
+local maxLockout = tonumber(ARGV[3])
+
+local locked = 0
+for i = 2, #KEYS, 2 do
+	locked = math.max(locked, redis.call('PTTL', KEYS[i]))
+end
+if locked > 0 then
+	return locked
+end
+
+for i = 1, #KEYS, 2 do
+	local limit = tonumber(ARGV[3 + (i + 1) / 2])
+	local count = redis.call('INCR', KEYS[i])
+	redis.call('PEXPIRE', KEYS[i], window)
+	if count >= limit then
+		local duration = math.min(lockout * 2 ^ (count - limit), maxLockout)
+		redis.call('SET', KEYS[i + 1], 1, 'PX', math.floor(duration))
+	end
+end
+
+return 0
+`)
+
+// releaseScript decrements the counter, dropping it at zero. The lock is left as is, because it could have been
+// set by a concurrent failed attempt, so aborted attempts can't be used to lift the lockouts.
+var releaseScript = redis.NewScript(`
+if redis.call('DECR', KEYS[1]) <= 0 then
+	redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+type LoginAttemptRepository struct {
+	client *redis.Client
+}
+
+var _ repositories.LoginAttemptRepository = (*LoginAttemptRepository)(nil)
+
+// Attempt implements repositories.LoginAttemptRepository.
+func (l *LoginAttemptRepository) Attempt(
+	ctx context.Context,
+	keys []repositories.AttemptKey,
+	window time.Duration,
+	lockout time.Duration,
+	maxLockout time.Duration,
+) (time.Duration, error) {
+	redisKeys := make([]string, 0, 2*len(keys))
+	args := []any{window.Milliseconds(), lockout.Milliseconds(), maxLockout.Milliseconds()}
+	for _, key := range keys {
+		redisKeys = append(redisKeys,
+			fmt.Sprintf("login_attempts:%s", key.Name),
+			fmt.Sprintf("login_locks:%s", key.Name),
+		)
+		args = append(args, key.Limit)
+	}
+
+	locked, err := attemptScript.Run(ctx, l.client, redisKeys, args...).Int64()
+	if err != nil {
+		return 0, fmt.Errorf("redis.LoginAttemptRepository.Attempt: %w", err)
+	}
+
+	return time.Duration(locked) * time.Millisecond, nil
+}
+
+// Release implements repositories.LoginAttemptRepository.
+func (l *LoginAttemptRepository) Release(ctx context.Context, key string) error {
+	err := releaseScript.Run(
+		ctx,
+		l.client,
+		[]string{fmt.Sprintf("login_attempts:%s", key)},
+	).Err()
+	if err != nil {
+		return fmt.Errorf("redis.LoginAttemptRepository.Release: %w", err)
+	}
+	return nil
+}
+
+// Reset implements repositories.LoginAttemptRepository.
+func (l *LoginAttemptRepository) Reset(ctx context.Context, key string) error {
+	err := l.client.Del(ctx, fmt.Sprintf("login_attempts:%s", key), fmt.Sprintf("login_locks:%s", key)).Err()
+	if err != nil {
+		return fmt.Errorf("redis.LoginAttemptRepository.Reset: %w", err)
+	}
+	return nil
+}
+
+func NewLoginAttemptRepository(client *redis.Client) *LoginAttemptRepository {
+	return &LoginAttemptRepository{client: client}
+}
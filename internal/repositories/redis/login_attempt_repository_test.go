@@ -0,0 +1,127 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maxdikun/weatherapp/internal/repositories"
+)
+
+const (
+	testWindow     = 15 * time.Minute
+	testLockout    = 30 * time.Second
+	testMaxLockout = 2 * time.Minute
+)
+
+func attempt(t *testing.T, repo *LoginAttemptRepository, keys ...repositories.AttemptKey) time.Duration {
+	t.Helper()
+
+	locked, err := repo.Attempt(context.Background(), keys, testWindow, testLockout, testMaxLockout)
+	if err != nil {
+		t.Fatalf("Attempt() error = %v", err)
+	}
+	return locked
+}
+
+func TestLoginAttemptRepositoryLockout(t *testing.T) {
+	server, client := newTestClient(t)
+	repo := NewLoginAttemptRepository(client)
+	key := repositories.AttemptKey{Name: "login:alice", Limit: 3}
+
+	// The attempt, which reaches the limit, is allowed and locks the next ones out.
+	for i := range 3 {
+		if locked := attempt(t, repo, key); locked != 0 {
+			t.Fatalf("attempt %d locked for %v, want allowed", i+1, locked)
+		}
+	}
+	if locked := attempt(t, repo, key); locked != testLockout {
+		t.Fatalf("attempt over the limit locked for %v, want %v", locked, testLockout)
+	}
+
+	// Every attempt after the lockout doubles it up to the maximum.
+	for _, want := range []time.Duration{2 * testLockout, 4 * testLockout, testMaxLockout} {
+		server.FastForward(testMaxLockout)
+		if locked := attempt(t, repo, key); locked != 0 {
+			t.Fatalf("attempt after the lockout locked for %v, want allowed", locked)
+		}
+		if locked := attempt(t, repo, key); locked != want {
+			t.Errorf("next lockout = %v, want %v", locked, want)
+		}
+	}
+}
+
+func TestLoginAttemptRepositoryLockedKeyBlocksOthers(t *testing.T) {
+	server, client := newTestClient(t)
+	repo := NewLoginAttemptRepository(client)
+	login := repositories.AttemptKey{Name: "login:alice", Limit: 1}
+	ip := repositories.AttemptKey{Name: "ip:192.0.2.1", Limit: 10}
+
+	attempt(t, repo, login, ip)
+	if locked := attempt(t, repo, login, ip); locked != testLockout {
+		t.Fatalf("attempt of the locked login locked for %v, want %v", locked, testLockout)
+	}
+
+	// The rejected attempt isn't counted for the other keys.
+	count, err := server.Get("login_attempts:ip:192.0.2.1")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if count != "1" {
+		t.Errorf("ip counter = %s, want 1", count)
+	}
+}
+
+func TestLoginAttemptRepositoryReleaseKeepsLockout(t *testing.T) {
+	ctx := context.Background()
+	_, client := newTestClient(t)
+	repo := NewLoginAttemptRepository(client)
+	key := repositories.AttemptKey{Name: "login:alice", Limit: 2}
+
+	// An attempt in flight is aborted after a concurrent one has reached the limit.
+	attempt(t, repo, key)
+	attempt(t, repo, key)
+	if err := repo.Release(ctx, key.Name); err != nil {
+		t.Fatalf("Release() error = %v", err)
+	}
+
+	if locked := attempt(t, repo, key); locked != testLockout {
+		t.Errorf("attempt after the release locked for %v, want %v", locked, testLockout)
+	}
+}
+
+func TestLoginAttemptRepositoryReleaseUndoesAttempt(t *testing.T) {
+	ctx := context.Background()
+	server, client := newTestClient(t)
+	repo := NewLoginAttemptRepository(client)
+	key := repositories.AttemptKey{Name: "login:alice", Limit: 2}
+
+	attempt(t, repo, key)
+	if err := repo.Release(ctx, key.Name); err != nil {
+		t.Fatalf("Release() error = %v", err)
+	}
+	if server.Exists("login_attempts:login:alice") {
+		t.Errorf("counter is kept after releasing the only attempt")
+	}
+
+	attempt(t, repo, key)
+	if locked := attempt(t, repo, key); locked != 0 {
+		t.Errorf("second counted attempt locked for %v, want allowed", locked)
+	}
+}
+
+func TestLoginAttemptRepositoryReset(t *testing.T) {
+	ctx := context.Background()
+	_, client := newTestClient(t)
+	repo := NewLoginAttemptRepository(client)
+	key := repositories.AttemptKey{Name: "login:alice", Limit: 1}
+
+	attempt(t, repo, key)
+	if err := repo.Reset(ctx, key.Name); err != nil {
+		t.Fatalf("Reset() error = %v", err)
+	}
+
+	if locked := attempt(t, repo, key); locked != 0 {
+		t.Errorf("attempt after the reset locked for %v, want allowed", locked)
+	}
+}
@@ -0,0 +1,135 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/maxdikun/weatherapp/internal/repositories"
+)
+
+// takeTokenScript refills every bucket for the time passed since the last call and takes a token from each of them,
+// only if all of them have one. ARGV contains the rate and the burst of every bucket in the order of KEYS.
+// Time of the redis server is used, so replicas with skewed clocks share the same budget.
+var takeTokenScript = redis.NewScript(`
+local time = redis.call('TIME')
+local now = tonumber(time[1]) + tonumber(time[2]) / 1000000
+
+local rates = {}
+local bursts = {}
+local tokens = {}
+local allowed = 1
+for i, key in ipairs(KEYS) do
+	rates[i] = tonumber(ARGV[2 * i - 1])
+	bursts[i] = tonumber(ARGV[2 * i])
+
+	local state = redis.call('HMGET', key, 'tokens', 'ts')
+	local current = tonumber(state[1]) or bursts[i]
+	local ts = tonumber(state[2]) or now
+	tokens[i] = math.min(bursts[i], current + math.max(0, now - ts) * rates[i])
+	if tokens[i] < 1 then
+		allowed = 0
+	end
+end
+
+local result = {}
+for i, key in ipairs(KEYS) do
+	local retry = 0
+	if tokens[i] < 1 then
+		retry = (1 - tokens[i]) / rates[i]
+	elseif allowed == 1 then
+		tokens[i] = tokens[i] - 1
+	end
+
+	local reset = (bursts[i] - tokens[i]) / rates[i]
+
+	redis.call('HSET', key, 'tokens', tokens[i], 'ts', now)
+	redis.call('EXPIRE', key, math.max(1, math.ceil(reset)))
+
+	local has = 0
+	if retry == 0 then
+		has = 1
+	end
+	table.insert(result, has)
+	table.insert(result, math.floor(tokens[i]))
+	table.insert(result, tostring(retry))
+	table.insert(result, tostring(reset))
+end
+
+return result
+`)
+
+type RateLimitRepository struct {
+	client *redis.Client
+}
+
+var _ repositories.RateLimitRepository = (*RateLimitRepository)(nil)
+
+// Take implements repositories.RateLimitRepository.
+func (r *RateLimitRepository) Take(ctx context.Context, buckets []repositories.TokenBucket) ([]repositories.BucketState, error) {
+	if len(buckets) == 0 {
+		return nil, nil
+	}
+
+	keys := make([]string, 0, len(buckets))
+	args := make([]any, 0, 2*len(buckets))
+	for _, bucket := range buckets {
+		keys = append(keys, fmt.Sprintf("rate_limits:%s", bucket.Key))
+		args = append(args, bucket.Rate, bucket.Burst)
+	}
+
+	result, err := takeTokenScript.Run(ctx, r.client, keys, args...).Slice()
+	if err != nil {
+		return nil, fmt.Errorf("redis.RateLimitRepository.Take: %w", err)
+	}
+
+	if len(result) != 4*len(buckets) {
+		return nil, fmt.Errorf("redis.RateLimitRepository.Take: unexpected result %v", result)
+	}
+
+	states := make([]repositories.BucketState, 0, len(buckets))
+	for i := 0; i < len(result); i += 4 {
+		allowed, _ := result[i].(int64)
+		remaining, _ := result[i+1].(int64)
+
+		retryAfter, err := parseSeconds(result[i+2])
+		if err != nil {
+			return nil, fmt.Errorf("redis.RateLimitRepository.Take: %w", err)
+		}
+
+		reset, err := parseSeconds(result[i+3])
+		if err != nil {
+			return nil, fmt.Errorf("redis.RateLimitRepository.Take: %w", err)
+		}
+
+		states = append(states, repositories.BucketState{
+			Allowed:    allowed == 1,
+			Remaining:  int(remaining),
+			RetryAfter: retryAfter,
+			Reset:      reset,
+		})
+	}
+
+	return states, nil
+}
+
+func parseSeconds(value any) (time.Duration, error) {
+	s, ok := value.(string)
+	if !ok {
+		return 0, fmt.Errorf("unexpected value %v", value)
+	}
+
+	seconds, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(seconds * float64(time.Second)), nil
+}
+
+func NewRateLimitRepository(client *redis.Client) *RateLimitRepository {
+	return &RateLimitRepository{client: client}
+}
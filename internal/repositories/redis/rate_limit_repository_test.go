@@ -0,0 +1,100 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maxdikun/weatherapp/internal/repositories"
+)
+
+func take(t *testing.T, repo *RateLimitRepository, buckets ...repositories.TokenBucket) []repositories.BucketState {
+	t.Helper()
+
+	states, err := repo.Take(context.Background(), buckets)
+	if err != nil {
+		t.Fatalf("Take() error = %v", err)
+	}
+	if len(states) != len(buckets) {
+		t.Fatalf("len(Take()) = %d, want %d", len(states), len(buckets))
+	}
+	return states
+}
+
+func TestRateLimitRepositoryTakesFromEveryBucket(t *testing.T) {
+	server, client := newTestClient(t)
+	server.SetTime(time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC))
+	repo := NewRateLimitRepository(client)
+
+	ip := repositories.TokenBucket{Key: "ip:192.0.2.1", Rate: 1, Burst: 5}
+	user := repositories.TokenBucket{Key: "user:alice", Rate: 1, Burst: 3}
+
+	states := take(t, repo, ip, user)
+	for i, want := range []int{4, 2} {
+		if !states[i].Allowed || states[i].Remaining != want {
+			t.Errorf("states[%d] = %+v, want allowed with %d remaining", i, states[i], want)
+		}
+	}
+	if states[1].Reset != time.Second {
+		t.Errorf("states[1].Reset = %v, want 1s", states[1].Reset)
+	}
+}
+
+func TestRateLimitRepositoryDeniedTakesNothing(t *testing.T) {
+	server, client := newTestClient(t)
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	server.SetTime(now)
+	repo := NewRateLimitRepository(client)
+
+	ip := repositories.TokenBucket{Key: "ip:192.0.2.1", Rate: 1, Burst: 5}
+	route := repositories.TokenBucket{Key: "route:login:ip:192.0.2.1", Rate: 0.5, Burst: 1}
+
+	take(t, repo, ip, route)
+
+	// The route budget is exhausted, so the IP budget isn't spent by the denied requests.
+	for range 3 {
+		states := take(t, repo, ip, route)
+		if !states[0].Allowed || states[0].Remaining != 4 {
+			t.Errorf("ip state = %+v, want a token left in the bucket with 4 remaining", states[0])
+		}
+		if states[1].Allowed || states[1].RetryAfter != 2*time.Second {
+			t.Errorf("route state = %+v, want denied with retry after 2s", states[1])
+		}
+	}
+
+	// The route budget is refilled, while the IP one is already full again.
+	server.SetTime(now.Add(2 * time.Second))
+	states := take(t, repo, ip, route)
+	if !states[0].Allowed || states[0].Remaining != 4 {
+		t.Errorf("ip state = %+v, want allowed with 4 remaining", states[0])
+	}
+	if !states[1].Allowed || states[1].Remaining != 0 {
+		t.Errorf("route state = %+v, want allowed with 0 remaining", states[1])
+	}
+}
+
+func TestRateLimitRepositoryRefill(t *testing.T) {
+	server, client := newTestClient(t)
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	server.SetTime(now)
+	repo := NewRateLimitRepository(client)
+
+	bucket := repositories.TokenBucket{Key: "user:alice", Rate: 2, Burst: 2}
+
+	take(t, repo, bucket)
+	take(t, repo, bucket)
+	if state := take(t, repo, bucket)[0]; state.Allowed || state.RetryAfter != 500*time.Millisecond {
+		t.Fatalf("state = %+v, want denied with retry after 500ms", state)
+	}
+
+	server.SetTime(now.Add(500 * time.Millisecond))
+	if state := take(t, repo, bucket)[0]; !state.Allowed || state.Remaining != 0 {
+		t.Errorf("state after refill = %+v, want allowed with 0 remaining", state)
+	}
+
+	// The bucket isn't filled over the burst.
+	server.SetTime(now.Add(time.Hour))
+	if state := take(t, repo, bucket)[0]; !state.Allowed || state.Remaining != 1 {
+		t.Errorf("state after idle hour = %+v, want allowed with 1 remaining", state)
+	}
+}
@@ -2,16 +2,35 @@ package redis
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
 
 	"github.com/maxdikun/weatherapp/internal/models"
 	"github.com/maxdikun/weatherapp/internal/repositories"
 )
 
+// rotateTokenScript replaces the token of the session, unless it was already rotated or the session was deleted.
+var rotateTokenScript = redis.NewScript(`
+local data = redis.call('GET', KEYS[2])
+if redis.call('GET', KEYS[1]) ~= ARGV[1] or not data or cjson.decode(data).TokenHash ~= ARGV[2] then
+	return 0
+end
+
+redis.call('DEL', KEYS[1])
+redis.call('SET', KEYS[2], ARGV[3], 'PX', ARGV[4])
+redis.call('SET', KEYS[3], ARGV[1], 'PX', ARGV[4])
+redis.call('SET', KEYS[4], ARGV[1], 'PX', ARGV[4])
+
+return 1
+`)
+
 type SessionRepository struct {
 	client *redis.Client
 }
@@ -20,7 +39,7 @@ var _ repositories.SessionRepository = (*SessionRepository)(nil)
 
 // Add implements repositories.SessionRepository.
 func (s *SessionRepository) Add(ctx context.Context, session models.Session) error {
-	data, err := json.Marshal(session)
+	data, err := json.Marshal(newSessionData(session))
 	if err != nil {
 		return fmt.Errorf("redis.SessionRepository.Add: %w", err)
 	}
@@ -28,7 +47,11 @@ func (s *SessionRepository) Add(ctx context.Context, session models.Session) err
 	pipe := s.client.Pipeline()
 
 	pipe.SetNX(ctx, fmt.Sprintf("sessions:%s", session.Id.String()), data, time.Until(session.ExpiresAt))
-	pipe.SetNX(ctx, fmt.Sprintf("session_tokens:%s", session.Token), session.Id.String(), time.Until(session.ExpiresAt))
+	pipe.SetNX(ctx, fmt.Sprintf("session_tokens:%s", hashToken(session.Token)), session.Id.String(), time.Until(session.ExpiresAt))
+	pipe.SAdd(ctx, fmt.Sprintf("user_sessions:%s", session.User.String()), session.Id.String())
+	// The index lives as long as the longest session of the user.
+	pipe.ExpireNX(ctx, fmt.Sprintf("user_sessions:%s", session.User.String()), time.Until(session.ExpiresAt))
+	pipe.ExpireGT(ctx, fmt.Sprintf("user_sessions:%s", session.User.String()), time.Until(session.ExpiresAt))
 
 	_, err = pipe.Exec(ctx)
 	if err != nil {
@@ -40,7 +63,7 @@ func (s *SessionRepository) Add(ctx context.Context, session models.Session) err
 
 // Delete implements repositories.SessionRepository.
 func (s *SessionRepository) Delete(ctx context.Context, token string) error {
-	sessionId, err := s.client.Get(ctx, fmt.Sprintf("session_tokens:%s", token)).Result()
+	sessionId, err := s.client.Get(ctx, fmt.Sprintf("session_tokens:%s", hashToken(token))).Result()
 	if err != nil {
 		if err == redis.Nil {
 			return &repositories.NotFoundError{
@@ -51,9 +74,15 @@ func (s *SessionRepository) Delete(ctx context.Context, token string) error {
 		return fmt.Errorf("redis.SessionRepository.Delete: %w", err)
 	}
 
-	pipe := s.client.Pipeline()
-	pipe.Del(ctx, fmt.Sprintf("session_tokens:%s", token))
+	session, err := s.findById(ctx, sessionId)
+	if err != nil {
+		return fmt.Errorf("redis.SessionRepository.Delete: %w", err)
+	}
+
+	pipe := s.client.TxPipeline()
+	pipe.Del(ctx, fmt.Sprintf("session_tokens:%s", session.TokenHash))
 	pipe.Del(ctx, fmt.Sprintf("sessions:%s", sessionId))
+	pipe.SRem(ctx, fmt.Sprintf("user_sessions:%s", session.User.String()), sessionId)
 	_, err = pipe.Exec(ctx)
 	if err != nil {
 		return fmt.Errorf("redis.SessionRepository.Delete: %w", err)
@@ -61,9 +90,116 @@ func (s *SessionRepository) Delete(ctx context.Context, token string) error {
 	return nil
 }
 
+// DeleteByUser implements repositories.SessionRepository.
+func (s *SessionRepository) DeleteByUser(ctx context.Context, user uuid.UUID) error {
+	indexKey := fmt.Sprintf("user_sessions:%s", user.String())
+
+	sessionIds, err := s.client.SMembers(ctx, indexKey).Result()
+	if err != nil {
+		return fmt.Errorf("redis.SessionRepository.DeleteByUser: %w", err)
+	}
+
+	pipe := s.client.TxPipeline()
+	for _, sessionId := range sessionIds {
+		session, err := s.findById(ctx, sessionId)
+		if err != nil {
+			var notFound *repositories.NotFoundError
+			if errors.As(err, &notFound) {
+				// Session has already expired, only the index entry is left.
+				continue
+			}
+			return fmt.Errorf("redis.SessionRepository.DeleteByUser: %w", err)
+		}
+
+		pipe.Del(ctx, fmt.Sprintf("session_tokens:%s", session.TokenHash))
+		pipe.Del(ctx, fmt.Sprintf("sessions:%s", sessionId))
+	}
+	pipe.Del(ctx, indexKey)
+
+	_, err = pipe.Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("redis.SessionRepository.DeleteByUser: %w", err)
+	}
+	return nil
+}
+
+// DeleteById implements repositories.SessionRepository.
+func (s *SessionRepository) DeleteById(ctx context.Context, id uuid.UUID) error {
+	session, err := s.findById(ctx, id.String())
+	if err != nil {
+		return fmt.Errorf("redis.SessionRepository.DeleteById: %w", err)
+	}
+
+	pipe := s.client.TxPipeline()
+	pipe.Del(ctx, fmt.Sprintf("session_tokens:%s", session.TokenHash))
+	pipe.Del(ctx, fmt.Sprintf("sessions:%s", id.String()))
+	pipe.SRem(ctx, fmt.Sprintf("user_sessions:%s", session.User.String()), id.String())
+	_, err = pipe.Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("redis.SessionRepository.DeleteById: %w", err)
+	}
+	return nil
+}
+
+// FindById implements repositories.SessionRepository.
+func (s *SessionRepository) FindById(ctx context.Context, id uuid.UUID) (models.Session, error) {
+	session, err := s.findById(ctx, id.String())
+	if err != nil {
+		return models.Session{}, fmt.Errorf("redis.SessionRepository.FindById: %w", err)
+	}
+	return session.toModel(), nil
+}
+
+// FindByUser implements repositories.SessionRepository.
+func (s *SessionRepository) FindByUser(ctx context.Context, user uuid.UUID) ([]models.Session, error) {
+	indexKey := fmt.Sprintf("user_sessions:%s", user.String())
+
+	sessionIds, err := s.client.SMembers(ctx, indexKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis.SessionRepository.FindByUser: %w", err)
+	}
+	if len(sessionIds) == 0 {
+		return []models.Session{}, nil
+	}
+
+	keys := make([]string, len(sessionIds))
+	for i, sessionId := range sessionIds {
+		keys[i] = fmt.Sprintf("sessions:%s", sessionId)
+	}
+
+	values, err := s.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis.SessionRepository.FindByUser: %w", err)
+	}
+
+	sessions := make([]models.Session, 0, len(values))
+	var expired []any
+	for i, value := range values {
+		data, ok := value.(string)
+		if !ok {
+			expired = append(expired, sessionIds[i])
+			continue
+		}
+
+		var session sessionData
+		if err := json.Unmarshal([]byte(data), &session); err != nil {
+			return nil, fmt.Errorf("redis.SessionRepository.FindByUser: %w", err)
+		}
+		sessions = append(sessions, session.toModel())
+	}
+
+	if len(expired) != 0 {
+		if err := s.client.SRem(ctx, indexKey, expired...).Err(); err != nil {
+			return nil, fmt.Errorf("redis.SessionRepository.FindByUser: %w", err)
+		}
+	}
+
+	return sessions, nil
+}
+
 // FindByToken implements repositories.SessionRepository.
 func (s *SessionRepository) FindByToken(ctx context.Context, token string) (models.Session, error) {
-	sessionId, err := s.client.Get(ctx, fmt.Sprintf("session_tokens:%s", token)).Result()
+	sessionId, err := s.client.Get(ctx, fmt.Sprintf("session_tokens:%s", hashToken(token))).Result()
 	if err != nil {
 		if err == redis.Nil {
 			return models.Session{}, &repositories.NotFoundError{
@@ -85,17 +221,145 @@ func (s *SessionRepository) FindByToken(ctx context.Context, token string) (mode
 		return models.Session{}, fmt.Errorf("redis.SessionRepository.FindByToken: %w", err)
 	}
 
-	var session models.Session
-	if err := json.Unmarshal([]byte(data), &session); err != nil {
+	var stored sessionData
+	if err := json.Unmarshal([]byte(data), &stored); err != nil {
 		return models.Session{}, fmt.Errorf("redis.SessionRepository.FindByToken: %w", err)
 	}
 
+	// The index could outlive the rotation of the token, only the current one is accepted.
+	if stored.TokenHash != hashToken(token) {
+		return models.Session{}, &repositories.NotFoundError{
+			Object: "session",
+			Field:  "token",
+		}
+	}
+
+	session := stored.toModel()
+	session.Token = token
+	return session, nil
+}
+
+// FindByRotatedToken implements repositories.SessionRepository.
+func (s *SessionRepository) FindByRotatedToken(ctx context.Context, token string) (models.Session, error) {
+	sessionId, err := s.client.Get(ctx, fmt.Sprintf("session_rotated_tokens:%s", hashToken(token))).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return models.Session{}, &repositories.NotFoundError{
+				Object: "session",
+				Field:  "token",
+			}
+		}
+		return models.Session{}, fmt.Errorf("redis.SessionRepository.FindByRotatedToken: %w", err)
+	}
+
+	session, err := s.findById(ctx, sessionId)
+	if err != nil {
+		return models.Session{}, fmt.Errorf("redis.SessionRepository.FindByRotatedToken: %w", err)
+	}
+
+	return session.toModel(), nil
+}
+
+// Rotate implements repositories.SessionRepository.
+//
+// The old token is remembered as rotated and can be found with FindByRotatedToken until the session expires.
+func (s *SessionRepository) Rotate(ctx context.Context, token string, session models.Session) error {
+	data, err := json.Marshal(newSessionData(session))
+	if err != nil {
+		return fmt.Errorf("redis.SessionRepository.Rotate: %w", err)
+	}
+
+	ttl := time.Until(session.ExpiresAt)
+	if ttl <= 0 {
+		return &repositories.NotFoundError{Object: "session", Field: "token"}
+	}
+
+	rotated, err := rotateTokenScript.Run(
+		ctx,
+		s.client,
+		[]string{
+			fmt.Sprintf("session_tokens:%s", hashToken(token)),
+			fmt.Sprintf("sessions:%s", session.Id.String()),
+			fmt.Sprintf("session_tokens:%s", hashToken(session.Token)),
+			fmt.Sprintf("session_rotated_tokens:%s", hashToken(token)),
+		},
+		session.Id.String(),
+		hashToken(token),
+		data,
+		ttl.Milliseconds(),
+	).Int()
+	if err != nil {
+		return fmt.Errorf("redis.SessionRepository.Rotate: %w", err)
+	}
+
+	if rotated == 0 {
+		return &repositories.NotFoundError{Object: "session", Field: "token"}
+	}
+	return nil
+}
+
+func (s *SessionRepository) findById(ctx context.Context, sessionId string) (sessionData, error) {
+	data, err := s.client.Get(ctx, fmt.Sprintf("sessions:%s", sessionId)).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return sessionData{}, &repositories.NotFoundError{
+				Object: "session",
+				Field:  "id",
+			}
+		}
+		return sessionData{}, err
+	}
+
+	var session sessionData
+	if err := json.Unmarshal([]byte(data), &session); err != nil {
+		return sessionData{}, err
+	}
+
 	return session, nil
 }
 
-// Update implements repositories.SessionRepository.
-func (s *SessionRepository) Update(ctx context.Context, session models.Session) error {
-	return s.Add(ctx, session)
+// sessionData is the stored form of models.Session.
+// Only a hash of the refresh token is kept, so a dump of the keyspace can't be used to take over sessions.
+type sessionData struct {
+	Id          uuid.UUID
+	User        uuid.UUID
+	TokenHash   string
+	CreatedAt   time.Time
+	RefreshedAt time.Time
+	ExpiresAt   time.Time
+	UserAgent   string
+	IP          string
+}
+
+func newSessionData(session models.Session) sessionData {
+	return sessionData{
+		Id:          session.Id,
+		User:        session.User,
+		TokenHash:   hashToken(session.Token),
+		CreatedAt:   session.CreatedAt,
+		RefreshedAt: session.RefreshedAt,
+		ExpiresAt:   session.ExpiresAt,
+		UserAgent:   session.UserAgent,
+		IP:          session.IP,
+	}
+}
+
+// toModel converts the data to models.Session, the token is left empty, because only its hash is known.
+func (data sessionData) toModel() models.Session {
+	return models.Session{
+		Id:          data.Id,
+		User:        data.User,
+		CreatedAt:   data.CreatedAt,
+		RefreshedAt: data.RefreshedAt,
+		ExpiresAt:   data.ExpiresAt,
+		UserAgent:   data.UserAgent,
+		IP:          data.IP,
+	}
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
 }
 
 func NewSessionRepository(client *redis.Client) *SessionRepository {
@@ -0,0 +1,190 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+	"github.com/maxdikun/weatherapp/internal/repositories"
+)
+
+// newTestClient starts an in-memory redis, which runs the scripts like the real one.
+func newTestClient(t *testing.T) (*miniredis.Miniredis, *redis.Client) {
+	t.Helper()
+
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	return server, client
+}
+
+func assertNotFound(t *testing.T, err error, call string) {
+	t.Helper()
+
+	var notFound *repositories.NotFoundError
+	if !errors.As(err, &notFound) {
+		t.Errorf("%s error = %v, want *repositories.NotFoundError", call, err)
+	}
+}
+
+func newTestSession(t *testing.T, repo *SessionRepository, token string) models.Session {
+	t.Helper()
+
+	session := models.Session{
+		Id:          uuid.New(),
+		User:        uuid.New(),
+		Token:       token,
+		CreatedAt:   time.Now(),
+		RefreshedAt: time.Now(),
+		ExpiresAt:   time.Now().Add(time.Hour),
+		UserAgent:   "test",
+		IP:          "192.0.2.1",
+	}
+	if err := repo.Add(context.Background(), session); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	return session
+}
+
+func rotated(session models.Session, token string) models.Session {
+	session.Token = token
+	session.RefreshedAt = time.Now()
+	return session
+}
+
+func TestSessionRepositoryRotate(t *testing.T) {
+	ctx := context.Background()
+	_, client := newTestClient(t)
+	repo := NewSessionRepository(client)
+	session := newTestSession(t, repo, "token-1")
+
+	if err := repo.Rotate(ctx, "token-1", rotated(session, "token-2")); err != nil {
+		t.Fatalf("Rotate() error = %v", err)
+	}
+
+	found, err := repo.FindByToken(ctx, "token-2")
+	if err != nil {
+		t.Fatalf("FindByToken(new) error = %v", err)
+	}
+	if found.Id != session.Id {
+		t.Errorf("FindByToken(new) = %v, want session %v", found.Id, session.Id)
+	}
+
+	_, err = repo.FindByToken(ctx, "token-1")
+	assertNotFound(t, err, "FindByToken(old)")
+
+	found, err = repo.FindByRotatedToken(ctx, "token-1")
+	if err != nil {
+		t.Fatalf("FindByRotatedToken(old) error = %v", err)
+	}
+	if found.Id != session.Id {
+		t.Errorf("FindByRotatedToken(old) = %v, want session %v", found.Id, session.Id)
+	}
+}
+
+func TestSessionRepositoryRotateReplay(t *testing.T) {
+	ctx := context.Background()
+	_, client := newTestClient(t)
+	repo := NewSessionRepository(client)
+	session := newTestSession(t, repo, "token-1")
+
+	if err := repo.Rotate(ctx, "token-1", rotated(session, "token-2")); err != nil {
+		t.Fatalf("Rotate() error = %v", err)
+	}
+
+	// The replayed token can't be rotated again, and the token of the replay isn't stored.
+	err := repo.Rotate(ctx, "token-1", rotated(session, "token-3"))
+	assertNotFound(t, err, "Rotate(replayed)")
+
+	_, err = repo.FindByToken(ctx, "token-3")
+	assertNotFound(t, err, "FindByToken(replay)")
+
+	if _, err := repo.FindByToken(ctx, "token-2"); err != nil {
+		t.Errorf("FindByToken(current) error = %v", err)
+	}
+}
+
+func TestSessionRepositoryRotateRace(t *testing.T) {
+	ctx := context.Background()
+	_, client := newTestClient(t)
+	repo := NewSessionRepository(client)
+	session := newTestSession(t, repo, "token-1")
+
+	tokens := []string{"token-a", "token-b", "token-c", "token-d"}
+	errs := make([]error, len(tokens))
+	var wg sync.WaitGroup
+	for i, token := range tokens {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = repo.Rotate(ctx, "token-1", rotated(session, token))
+		}()
+	}
+	wg.Wait()
+
+	var winners []string
+	for i, err := range errs {
+		if err == nil {
+			winners = append(winners, tokens[i])
+			continue
+		}
+		assertNotFound(t, err, "Rotate(lost race)")
+	}
+	if len(winners) != 1 {
+		t.Fatalf("rotations succeeded with %v, want exactly one", winners)
+	}
+
+	for _, token := range tokens {
+		_, err := repo.FindByToken(ctx, token)
+		if token == winners[0] {
+			if err != nil {
+				t.Errorf("FindByToken(winner) error = %v", err)
+			}
+			continue
+		}
+		assertNotFound(t, err, "FindByToken(loser)")
+	}
+}
+
+func TestSessionRepositoryRotateDeleted(t *testing.T) {
+	ctx := context.Background()
+	_, client := newTestClient(t)
+	repo := NewSessionRepository(client)
+	session := newTestSession(t, repo, "token-1")
+
+	if err := repo.DeleteById(ctx, session.Id); err != nil {
+		t.Fatalf("DeleteById() error = %v", err)
+	}
+
+	err := repo.Rotate(ctx, "token-1", rotated(session, "token-2"))
+	assertNotFound(t, err, "Rotate(deleted)")
+
+	_, err = repo.FindByToken(ctx, "token-2")
+	assertNotFound(t, err, "FindByToken(deleted)")
+}
+
+func TestSessionRepositoryFindByStaleToken(t *testing.T) {
+	ctx := context.Background()
+	_, client := newTestClient(t)
+	repo := NewSessionRepository(client)
+	session := newTestSession(t, repo, "token-1")
+
+	if err := repo.Rotate(ctx, "token-1", rotated(session, "token-2")); err != nil {
+		t.Fatalf("Rotate() error = %v", err)
+	}
+
+	// An index entry, which outlived the rotation, doesn't lead to the session.
+	if err := client.Set(ctx, "session_tokens:"+hashToken("token-1"), session.Id.String(), time.Hour).Err(); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	_, err := repo.FindByToken(ctx, "token-1")
+	assertNotFound(t, err, "FindByToken(stale)")
+}
@@ -3,12 +3,28 @@ package repositories
 import (
 	"context"
 
+	"github.com/google/uuid"
+
 	"github.com/maxdikun/weatherapp/internal/models"
 )
 
+// SessionRepository stores sessions of the users.
+//
+// Implementations may keep only a hash of the refresh token,
+// so only sessions returned by FindByToken are guaranteed to have it set.
 type SessionRepository interface {
+	FindById(ctx context.Context, id uuid.UUID) (models.Session, error)
 	FindByToken(ctx context.Context, token string) (models.Session, error)
+	// FindByRotatedToken finds the session which was previously using the token,
+	// but got it replaced by a Rotate.
+	FindByRotatedToken(ctx context.Context, token string) (models.Session, error)
+	FindByUser(ctx context.Context, user uuid.UUID) ([]models.Session, error)
 	Add(ctx context.Context, session models.Session) error
-	Update(ctx context.Context, session models.Session) error
+	// Rotate stores the session with the new token, if the token is still its current one.
+	// The check and the replacement are atomic, so only one of the concurrent rotations
+	// with the same token succeeds, the others get NotFoundError.
+	Rotate(ctx context.Context, token string, session models.Session) error
 	Delete(ctx context.Context, token string) error
+	DeleteById(ctx context.Context, id uuid.UUID) error
+	DeleteByUser(ctx context.Context, user uuid.UUID) error
 }
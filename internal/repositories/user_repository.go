@@ -12,4 +12,12 @@ type UserRepository interface {
 	FindById(ctx context.Context, id uuid.UUID) (models.User, error)
 	FindByLogin(ctx context.Context, login string) (models.User, error)
 	Add(ctx context.Context, user models.User) error
+	UpdatePassword(ctx context.Context, id uuid.UUID, password string) error
+	// ChangePassword replaces the password and adds the notification to the outbox in one transaction,
+	// so the user is always notified about the change.
+	ChangePassword(ctx context.Context, id uuid.UUID, password string, notification models.Notification) error
+	// FindPreferences fails with NotFoundError if the user has never saved the preferences.
+	FindPreferences(ctx context.Context, user uuid.UUID) (models.Preferences, error)
+	// SavePreferences creates or replaces the preferences of the user.
+	SavePreferences(ctx context.Context, preferences models.Preferences) error
 }
@@ -0,0 +1,209 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+	"github.com/maxdikun/weatherapp/internal/repositories"
+)
+
+// AlertEvaluator periodically checks the alert subscriptions against the hourly forecast
+// and records the triggered alerts together with the notifications about them, which are also
+// published to the streams of the connected clients. A subscription triggers at most once per day,
+// so repeated evaluations and several running evaluators don't produce duplicates.
+type AlertEvaluator struct {
+	logger *slog.Logger
+
+	alertStorage repositories.AlertRepository
+	events       repositories.EventRepository
+	weatherSvc   *WeatherService
+	interval     time.Duration
+	concurrency  int
+}
+
+// Run evaluates the subscriptions every interval until the context is canceled.
+func (e *AlertEvaluator) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		e.Evaluate(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Evaluate checks every subscription once, the forecast is requested once per location.
+func (e *AlertEvaluator) Evaluate(ctx context.Context) {
+	targets, err := e.alertStorage.FindTargets(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			e.logger.Error("Failed to find alert subscriptions", "err", err)
+		}
+		return
+	}
+
+	var group errgroup.Group
+	group.SetLimit(e.concurrency)
+
+	now := time.Now()
+	// Targets are grouped by location, so every group is a run of the same location.
+	for start := 0; start < len(targets); {
+		end := start + 1
+		for end < len(targets) && targets[end].Subscription.Location == targets[start].Subscription.Location {
+			end++
+		}
+
+		location := targets[start:end]
+		group.Go(func() error {
+			e.evaluateLocation(ctx, location, now)
+			return nil
+		})
+		start = end
+	}
+
+	_ = group.Wait()
+}
+
+func (e *AlertEvaluator) evaluateLocation(ctx context.Context, targets []models.AlertTarget, now time.Time) {
+	forecast, err := e.weatherSvc.HourlyForecast(ctx, targets[0].Coordinates, MaxForecastHours)
+	if err != nil {
+		if ctx.Err() == nil {
+			e.logger.Warn("Failed to get forecast for alerts", "location", targets[0].Subscription.Location, "err", err)
+		}
+		return
+	}
+
+	for _, target := range targets {
+		alert, ok := matchAlert(target, forecast, now)
+		if !ok {
+			continue
+		}
+
+		err := e.alertStorage.AddAlert(ctx, alert, alertNotification(target, alert))
+		var alreadyExists *repositories.AlreadyExistsError
+		var notFound *repositories.NotFoundError
+		switch {
+		case err == nil:
+			e.logger.Info("Alert is triggered",
+				"subscription", alert.Subscription, "user", alert.User, "metric", alert.Metric, "value", alert.Value)
+			if err := e.events.Publish(ctx, models.Event{Kind: models.EventAlert, Alert: &alert}); err != nil {
+				e.logger.Warn("Failed to publish alert", "alert", alert.Id, "err", err)
+			}
+		case errors.As(err, &alreadyExists):
+			// The subscription has already triggered on this day.
+		case errors.As(err, &notFound):
+			// The subscription was deleted during the evaluation.
+		case ctx.Err() == nil:
+			e.logger.Error("Failed to record alert", "subscription", alert.Subscription, "err", err)
+		}
+	}
+}
+
+// matchAlert returns the alert for the first hour in the period of the subscription, which matches its rule.
+func matchAlert(target models.AlertTarget, forecast []models.HourlyForecast, now time.Time) (models.Alert, bool) {
+	subscription := target.Subscription
+	location := target.Location()
+	from, to := alertPeriod(subscription.Period, now.In(location))
+
+	for _, f := range forecast {
+		// The hour is in the period if any part of it is.
+		if !f.Time.Add(time.Hour).After(from) || !f.Time.Before(to) {
+			continue
+		}
+
+		value := alertMetricValue(f, subscription.Metric)
+		// Zero gusts are omitted by the providers, when they are unknown, so they can't match either way.
+		if subscription.Metric == models.AlertWindGust && value == 0 {
+			continue
+		}
+		matches := value > subscription.Threshold
+		if subscription.Operator == models.AlertBelow {
+			matches = value < subscription.Threshold
+		}
+		if !matches {
+			continue
+		}
+
+		year, month, day := f.Time.In(location).Date()
+		return models.Alert{
+			Id:           uuid.New(),
+			Subscription: subscription.Id,
+			User:         subscription.User,
+			Location:     subscription.Location,
+			Metric:       subscription.Metric,
+			Operator:     subscription.Operator,
+			Threshold:    subscription.Threshold,
+			Value:        value,
+			ForecastTime: f.Time,
+			Day:          time.Date(year, month, day, 0, 0, 0, 0, time.UTC),
+			TriggeredAt:  now,
+		}, true
+	}
+
+	return models.Alert{}, false
+}
+
+// alertPeriod returns the bounds of the period, now should be in the time zone of the user.
+func alertPeriod(period models.AlertPeriod, now time.Time) (time.Time, time.Time) {
+	year, month, day := now.Date()
+	today := time.Date(year, month, day, 0, 0, 0, 0, now.Location())
+
+	switch period {
+	case models.AlertToday:
+		return now, today.AddDate(0, 0, 1)
+	case models.AlertTomorrow:
+		return today.AddDate(0, 0, 1), today.AddDate(0, 0, 2)
+	default:
+		return now, now.Add(24 * time.Hour)
+	}
+}
+
+func alertMetricValue(f models.HourlyForecast, metric models.AlertMetric) float64 {
+	switch metric {
+	case models.AlertTemperature:
+		return f.Temperature
+	case models.AlertFeelsLike:
+		return f.FeelsLike
+	case models.AlertHumidity:
+		return f.Humidity
+	case models.AlertWindSpeed:
+		return f.WindSpeed
+	case models.AlertWindGust:
+		return f.WindGust
+	case models.AlertPrecipitation:
+		return f.Precipitation
+	case models.AlertPrecipitationProbability:
+		return f.PrecipitationProbability
+	}
+	return 0
+}
+
+// NewAlertEvaluator creates the evaluator, which checks forecasts of at most concurrency locations at once.
+func NewAlertEvaluator(
+	logger *slog.Logger,
+	alertStorage repositories.AlertRepository,
+	events repositories.EventRepository,
+	weatherSvc *WeatherService,
+	interval time.Duration,
+	concurrency int,
+) *AlertEvaluator {
+	return &AlertEvaluator{
+		logger:       logger,
+		alertStorage: alertStorage,
+		events:       events,
+		weatherSvc:   weatherSvc,
+		interval:     interval,
+		concurrency:  concurrency,
+	}
+}
@@ -0,0 +1,231 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+	"github.com/maxdikun/weatherapp/internal/repositories"
+)
+
+const MaxListedAlerts = 100
+
+// alertThresholdRanges contain the allowed thresholds of the metrics.
+var alertThresholdRanges = map[models.AlertMetric][2]float64{
+	models.AlertTemperature:              {-100, 100},
+	models.AlertFeelsLike:                {-100, 100},
+	models.AlertHumidity:                 {0, 100},
+	models.AlertWindSpeed:                {0, 150},
+	models.AlertWindGust:                 {0, 150},
+	models.AlertPrecipitation:            {0, 500},
+	models.AlertPrecipitationProbability: {0, 100},
+}
+
+// NewAlertSubscription is a rule to subscribe to on a saved location of the user.
+type NewAlertSubscription struct {
+	LocationId uuid.UUID
+	Metric     models.AlertMetric
+	Operator   models.AlertOperator
+	Threshold  float64
+	Period     models.AlertPeriod
+}
+
+// AlertSubscriptionUpdate contains changed fields of the rule, nil fields are left as is.
+type AlertSubscriptionUpdate struct {
+	Metric    *models.AlertMetric
+	Operator  *models.AlertOperator
+	Threshold *float64
+	Period    *models.AlertPeriod
+}
+
+type AlertService struct {
+	logger *slog.Logger
+
+	alertStorage     repositories.AlertRepository
+	locationSvc      *LocationService
+	maxSubscriptions int
+}
+
+// ListSubscriptions returns alert subscriptions of the user in the order of creation.
+func (svc *AlertService) ListSubscriptions(ctx context.Context, user uuid.UUID) ([]models.AlertSubscription, error) {
+	subscriptions, err := svc.alertStorage.FindSubscriptionsByUser(ctx, user)
+	if err != nil {
+		svc.logger.Error("Failed to list alert subscriptions", "user", user, "err", err)
+		return nil, ErrInternal
+	}
+	return subscriptions, nil
+}
+
+// GetSubscription returns the alert subscription of the user.
+func (svc *AlertService) GetSubscription(ctx context.Context, user uuid.UUID, id uuid.UUID) (models.AlertSubscription, error) {
+	subscription, err := svc.alertStorage.FindSubscriptionById(ctx, id)
+	if err != nil {
+		return models.AlertSubscription{}, svc.storageError(err, "Failed to find alert subscription", "subscription", id)
+	}
+
+	// Subscriptions of other users should be indistinguishable from missing ones.
+	if subscription.User != user {
+		return models.AlertSubscription{}, ErrAlertSubscriptionNotFound
+	}
+
+	return subscription, nil
+}
+
+// AddSubscription subscribes the user to the rule on the saved location.
+func (svc *AlertService) AddSubscription(ctx context.Context, user uuid.UUID, subscription NewAlertSubscription) (models.AlertSubscription, error) {
+	err := validateAlertRule(subscription.Metric, subscription.Operator, subscription.Threshold, subscription.Period)
+	if err != nil {
+		return models.AlertSubscription{}, err
+	}
+
+	if _, err := svc.locationSvc.Get(ctx, user, subscription.LocationId); err != nil {
+		return models.AlertSubscription{}, err
+	}
+
+	created := models.AlertSubscription{
+		Id:        uuid.New(),
+		User:      user,
+		Location:  subscription.LocationId,
+		Metric:    subscription.Metric,
+		Operator:  subscription.Operator,
+		Threshold: subscription.Threshold,
+		Period:    subscription.Period,
+		CreatedAt: time.Now(),
+	}
+	// The location could have been deleted after it was checked.
+	if err := svc.alertStorage.AddSubscription(ctx, created, svc.maxSubscriptions); err != nil {
+		return models.AlertSubscription{}, svc.storageError(err, "Failed to add alert subscription", "user", user)
+	}
+
+	return created, nil
+}
+
+// UpdateSubscription changes the rule of the alert subscription of the user.
+func (svc *AlertService) UpdateSubscription(
+	ctx context.Context,
+	user uuid.UUID,
+	id uuid.UUID,
+	update AlertSubscriptionUpdate,
+) (models.AlertSubscription, error) {
+	subscription, err := svc.GetSubscription(ctx, user, id)
+	if err != nil {
+		return models.AlertSubscription{}, err
+	}
+
+	if update.Metric != nil {
+		subscription.Metric = *update.Metric
+	}
+	if update.Operator != nil {
+		subscription.Operator = *update.Operator
+	}
+	if update.Threshold != nil {
+		subscription.Threshold = *update.Threshold
+	}
+	if update.Period != nil {
+		subscription.Period = *update.Period
+	}
+
+	err = validateAlertRule(subscription.Metric, subscription.Operator, subscription.Threshold, subscription.Period)
+	if err != nil {
+		return models.AlertSubscription{}, err
+	}
+
+	if err := svc.alertStorage.UpdateSubscription(ctx, subscription); err != nil {
+		return models.AlertSubscription{}, svc.storageError(err, "Failed to update alert subscription", "subscription", id)
+	}
+
+	return subscription, nil
+}
+
+// DeleteSubscription unsubscribes the user, the alerts triggered by the subscription are deleted too.
+func (svc *AlertService) DeleteSubscription(ctx context.Context, user uuid.UUID, id uuid.UUID) error {
+	if _, err := svc.GetSubscription(ctx, user, id); err != nil {
+		return err
+	}
+
+	if err := svc.alertStorage.DeleteSubscription(ctx, id); err != nil {
+		return svc.storageError(err, "Failed to delete alert subscription", "subscription", id)
+	}
+
+	return nil
+}
+
+// ListAlerts returns at most limit alerts triggered for the user, the latest come first.
+func (svc *AlertService) ListAlerts(ctx context.Context, user uuid.UUID, limit int) ([]models.Alert, error) {
+	if limit < 1 || limit > MaxListedAlerts {
+		return nil, &ValidationError{Field: "limit", Message: fmt.Sprintf("should be between 1 and %d", MaxListedAlerts)}
+	}
+
+	alerts, err := svc.alertStorage.FindAlertsByUser(ctx, user, limit)
+	if err != nil {
+		svc.logger.Error("Failed to list alerts", "user", user, "err", err)
+		return nil, ErrInternal
+	}
+	return alerts, nil
+}
+
+// storageError translates errors of the repository, unexpected ones are logged with the message and args.
+func (svc *AlertService) storageError(err error, msg string, args ...any) error {
+	var notFound *repositories.NotFoundError
+	if errors.As(err, &notFound) {
+		switch notFound.Object {
+		case "alert subscription":
+			return ErrAlertSubscriptionNotFound
+		case "location":
+			return ErrLocationNotFound
+		}
+	}
+	var limitExceeded *repositories.LimitExceededError
+	if errors.As(err, &limitExceeded) {
+		return &LimitError{Object: "alert subscription", Limit: limitExceeded.Limit}
+	}
+
+	svc.logger.Error(msg, append(args, "err", err)...)
+	return ErrInternal
+}
+
+func validateAlertRule(metric models.AlertMetric, operator models.AlertOperator, threshold float64, period models.AlertPeriod) error {
+	var errs []error
+
+	thresholdRange, ok := alertThresholdRanges[metric]
+	if !ok {
+		errs = append(errs, &ValidationError{Field: "metric", Message: "is unknown"})
+	} else if math.IsNaN(threshold) || threshold < thresholdRange[0] || threshold > thresholdRange[1] {
+		errs = append(errs, &ValidationError{
+			Field:   "threshold",
+			Message: fmt.Sprintf("should be between %g and %g for %s", thresholdRange[0], thresholdRange[1], metric),
+		})
+	}
+
+	if operator != models.AlertAbove && operator != models.AlertBelow {
+		errs = append(errs, &ValidationError{Field: "operator", Message: "should be one of above or below"})
+	}
+
+	switch period {
+	case models.AlertNext24Hours, models.AlertToday, models.AlertTomorrow:
+	default:
+		errs = append(errs, &ValidationError{Field: "period", Message: "should be one of next24h, today or tomorrow"})
+	}
+
+	return errors.Join(errs...)
+}
+
+func NewAlertService(
+	logger *slog.Logger,
+	alertStorage repositories.AlertRepository,
+	locationSvc *LocationService,
+	maxSubscriptions int,
+) *AlertService {
+	return &AlertService{
+		logger:           logger,
+		alertStorage:     alertStorage,
+		locationSvc:      locationSvc,
+		maxSubscriptions: maxSubscriptions,
+	}
+}
@@ -3,12 +3,27 @@ package services
 import (
 	"errors"
 	"fmt"
+	"time"
 )
 
 var (
-	ErrInternal = errors.New("internal service error")
+	ErrInternal            = errors.New("internal service error")
+	ErrInvalidCredentials  = errors.New("invalid login or password")
+	ErrInvalidToken        = errors.New("invalid or expired token")
+	ErrSessionNotFound     = errors.New("session not found")
+	ErrWeatherUnavailable  = errors.New("weather data is unavailable")
+	ErrLocationNotFound    = errors.New("location not found")
+	ErrPlaceNotFound       = errors.New("place not found")
+	ErrGeocoderUnavailable = errors.New("geocoding is unavailable")
+
+	ErrAlertSubscriptionNotFound = errors.New("alert subscription not found")
+
+	ErrStreamClosed = errors.New("stream is closed by the server")
+	ErrSlowConsumer = errors.New("stream consumer is too slow")
 )
 
+// ValidationError is returned when provided data is invalid.
+// Several of them can be combined with errors.Join to report every failing field.
 type ValidationError struct {
 	Field   string
 	Message string
@@ -20,3 +35,76 @@ var _ error = (*ValidationError)(nil)
 func (err *ValidationError) Error() string {
 	return fmt.Sprintf("field '%s' is invalid: %s", err.Field, err.Message)
 }
+
+// ConflictError is returned when the object can't be stored, because it conflicts with an existing one.
+type ConflictError struct {
+	Object string
+	Field  string
+}
+
+var _ error = (*ConflictError)(nil)
+
+// Error implements error.
+func (err *ConflictError) Error() string {
+	return fmt.Sprintf("%s with provided '%s' already exists", err.Object, err.Field)
+}
+
+// LimitError is returned when the user already has the maximum number of objects.
+type LimitError struct {
+	Object string
+	Limit  int
+}
+
+var _ error = (*LimitError)(nil)
+
+// Error implements error.
+func (err *LimitError) Error() string {
+	return fmt.Sprintf("at most %d objects '%s' are allowed", err.Limit, err.Object)
+}
+
+// LockedError is returned when the attempts are temporarily forbidden after too many failures.
+type LockedError struct {
+	RetryAfter time.Duration
+}
+
+var _ error = (*LockedError)(nil)
+
+// Error implements error.
+func (err *LockedError) Error() string {
+	return fmt.Sprintf("too many failed attempts, retry after %s", err.RetryAfter.Round(time.Second))
+}
+
+// RateLimitError is returned when the request exceeds the rate limit.
+type RateLimitError struct {
+	RetryAfter time.Duration
+}
+
+var _ error = (*RateLimitError)(nil)
+
+// Error implements error.
+func (err *RateLimitError) Error() string {
+	return fmt.Sprintf("rate limit exceeded, retry after %s", err.RetryAfter.Round(time.Second))
+}
+
+// ValidationErrors collects every ValidationError in the tree of err.
+func ValidationErrors(err error) []*ValidationError {
+	var result []*ValidationError
+
+	var walk func(err error)
+	walk = func(err error) {
+		switch e := err.(type) {
+		case nil:
+		case *ValidationError:
+			result = append(result, e)
+		case interface{ Unwrap() []error }:
+			for _, err := range e.Unwrap() {
+				walk(err)
+			}
+		case interface{ Unwrap() error }:
+			walk(e.Unwrap())
+		}
+	}
+	walk(err)
+
+	return result
+}
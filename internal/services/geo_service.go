@@ -0,0 +1,94 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+	"github.com/maxdikun/weatherapp/internal/providers"
+)
+
+const (
+	MaxPlaceSearchResults = 20
+	maxPlaceQueryLength   = 200
+	maxPlaceIdLength      = 64
+)
+
+type GeoService struct {
+	logger *slog.Logger
+
+	geocoder providers.Geocoder
+}
+
+// Search returns at most limit places matching the query.
+func (svc *GeoService) Search(ctx context.Context, query string, limit int) ([]models.Place, error) {
+	query = strings.TrimSpace(query)
+
+	var errs []error
+	if query == "" {
+		errs = append(errs, &ValidationError{Field: "q", Message: "should not be empty"})
+	} else if utf8.RuneCountInString(query) > maxPlaceQueryLength {
+		errs = append(errs, &ValidationError{Field: "q", Message: fmt.Sprintf("should be at most %d characters long", maxPlaceQueryLength)})
+	}
+	if limit < 1 || limit > MaxPlaceSearchResults {
+		errs = append(errs, &ValidationError{Field: "limit", Message: fmt.Sprintf("should be between 1 and %d", MaxPlaceSearchResults)})
+	}
+	if len(errs) != 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	places, err := svc.geocoder.Search(ctx, query, limit)
+	if err != nil {
+		return nil, svc.geocoderError(err)
+	}
+	return places, nil
+}
+
+// Reverse returns the place at the coordinates.
+func (svc *GeoService) Reverse(ctx context.Context, coordinates models.Coordinates) (models.Place, error) {
+	if err := validateCoordinates(coordinates); err != nil {
+		return models.Place{}, err
+	}
+
+	place, err := svc.geocoder.Reverse(ctx, coordinates)
+	if err != nil {
+		return models.Place{}, svc.geocoderError(err)
+	}
+	return place, nil
+}
+
+// Place returns the place by the id from the results of Search or Reverse.
+func (svc *GeoService) Place(ctx context.Context, id string) (models.Place, error) {
+	if id == "" || len(id) > maxPlaceIdLength {
+		return models.Place{}, ErrPlaceNotFound
+	}
+
+	place, err := svc.geocoder.Lookup(ctx, id)
+	if err != nil {
+		return models.Place{}, svc.geocoderError(err)
+	}
+	return place, nil
+}
+
+func (svc *GeoService) geocoderError(err error) error {
+	if errors.Is(err, providers.ErrNotFound) {
+		return ErrPlaceNotFound
+	}
+	if errors.Is(err, context.Canceled) {
+		return err
+	}
+
+	svc.logger.Error("Geocoder failed", "err", err)
+	return ErrGeocoderUnavailable
+}
+
+func NewGeoService(logger *slog.Logger, geocoder providers.Geocoder) *GeoService {
+	return &GeoService{
+		logger:   logger,
+		geocoder: geocoder,
+	}
+}
@@ -0,0 +1,185 @@
+package services
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const minRSAKeyBits = 2048
+
+// JSONWebKey is a public key in the format of RFC 7517.
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	Use string `json:"use,omitempty"`
+
+	// RSA parameters.
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+
+	// OKP parameters.
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// NewPrivateKey creates a RS256 or EdDSA key, which signs tokens with the private key.
+// The id of the key is its RFC 7638 thumbprint.
+func NewPrivateKey(private crypto.Signer) (SigningKey, error) {
+	key, err := NewPublicKey(private.Public())
+	if err != nil {
+		return SigningKey{}, err
+	}
+
+	key.SignKey = private
+	return key, nil
+}
+
+// NewPublicKey creates a RS256 or EdDSA key, which only validates tokens.
+// The id of the key is its RFC 7638 thumbprint.
+func NewPublicKey(public crypto.PublicKey) (SigningKey, error) {
+	var method jwt.SigningMethod
+
+	switch public := public.(type) {
+	case *rsa.PublicKey:
+		if public.N.BitLen() < minRSAKeyBits {
+			return SigningKey{}, fmt.Errorf("RSA key should be at least %d bits long", minRSAKeyBits)
+		}
+		method = jwt.SigningMethodRS256
+	case ed25519.PublicKey:
+		method = jwt.SigningMethodEdDSA
+	default:
+		return SigningKey{}, fmt.Errorf("unsupported key type %T", public)
+	}
+
+	key := SigningKey{Method: method, VerifyKey: public}
+
+	thumbprint, err := jwkThumbprint(key.JWK())
+	if err != nil {
+		return SigningKey{}, err
+	}
+	key.Id = thumbprint
+
+	return key, nil
+}
+
+// ParsePrivateKeyPEM parses a PKCS #8 or PKCS #1 encoded private key.
+func ParsePrivateKeyPEM(data []byte) (SigningKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return SigningKey{}, errors.New("no PEM block found")
+	}
+
+	var private any
+	var err error
+	switch block.Type {
+	case "PRIVATE KEY":
+		private, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		private, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		return SigningKey{}, fmt.Errorf("unsupported PEM block type '%s'", block.Type)
+	}
+	if err != nil {
+		return SigningKey{}, err
+	}
+
+	signer, ok := private.(crypto.Signer)
+	if !ok {
+		return SigningKey{}, fmt.Errorf("unsupported key type %T", private)
+	}
+
+	return NewPrivateKey(signer)
+}
+
+// ParsePublicKeyPEM parses a PKIX or PKCS #1 encoded public key.
+func ParsePublicKeyPEM(data []byte) (SigningKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return SigningKey{}, errors.New("no PEM block found")
+	}
+
+	var public any
+	var err error
+	switch block.Type {
+	case "PUBLIC KEY":
+		public, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		public, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		return SigningKey{}, fmt.Errorf("unsupported PEM block type '%s'", block.Type)
+	}
+	if err != nil {
+		return SigningKey{}, err
+	}
+
+	return NewPublicKey(public)
+}
+
+// JWK returns the public part of the key. HMAC keys have no public part, so they return false.
+func (key SigningKey) JWK() (JSONWebKey, bool) {
+	switch public := key.VerifyKey.(type) {
+	case *rsa.PublicKey:
+		return JSONWebKey{
+			Kty: "RSA",
+			Kid: key.Id,
+			Alg: key.Method.Alg(),
+			Use: "sig",
+			N:   base64.RawURLEncoding.EncodeToString(public.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes()),
+		}, true
+	case ed25519.PublicKey:
+		return JSONWebKey{
+			Kty: "OKP",
+			Kid: key.Id,
+			Alg: key.Method.Alg(),
+			Use: "sig",
+			Crv: "Ed25519",
+			X:   base64.RawURLEncoding.EncodeToString(public),
+		}, true
+	}
+	return JSONWebKey{}, false
+}
+
+// jwkThumbprint computes RFC 7638 thumbprint of the key.
+func jwkThumbprint(jwk JSONWebKey, ok bool) (string, error) {
+	if !ok {
+		return "", errors.New("key has no public part")
+	}
+
+	// Members are marshaled in lexicographic order, as required by the RFC.
+	var members any
+	switch jwk.Kty {
+	case "RSA":
+		members = struct {
+			E   string `json:"e"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+		}{jwk.E, jwk.Kty, jwk.N}
+	case "OKP":
+		members = struct {
+			Crv string `json:"crv"`
+			Kty string `json:"kty"`
+			X   string `json:"x"`
+		}{jwk.Crv, jwk.Kty, jwk.X}
+	}
+
+	data, err := json.Marshal(members)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+	return base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,125 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// SigningKey is a key used to sign and validate access tokens.
+type SigningKey struct {
+	Id        string
+	Method    jwt.SigningMethod
+	SignKey   any
+	VerifyKey any
+}
+
+// minHMACSecretBytes is the size of the HS256 output, shorter secrets make the signatures easier to forge.
+const minHMACSecretBytes = 32
+
+// NewHMACKey creates a HS256 key from the shared secret.
+// Tokens signed by it can be validated only by the holders of the secret.
+func NewHMACKey(id string, secret []byte) (SigningKey, error) {
+	if len(secret) < minHMACSecretBytes {
+		return SigningKey{}, fmt.Errorf("HMAC secret of key '%s' should be at least %d bytes long", id, minHMACSecretBytes)
+	}
+
+	return SigningKey{
+		Id:        id,
+		Method:    jwt.SigningMethodHS256,
+		SignKey:   secret,
+		VerifyKey: secret,
+	}, nil
+}
+
+// Keyring holds the keys accepted for validation of access tokens.
+//
+// Only one of them is used to sign new tokens, the rest are kept until
+// every token signed by them expires, so the keys can be rotated
+// without logging everyone out.
+type Keyring struct {
+	signing string
+	keys    map[string]SigningKey
+	methods []string
+}
+
+// Sign signs the claims with the active key and puts its id into the "kid" header.
+func (k *Keyring) Sign(claims jwt.Claims) (string, error) {
+	key := k.keys[k.signing]
+
+	token := jwt.NewWithClaims(key.Method, claims)
+	token.Header["kid"] = key.Id
+
+	return token.SignedString(key.SignKey)
+}
+
+// Parse validates the token with the key referenced by its "kid" header.
+func (k *Keyring) Parse(tokenString string, claims jwt.Claims, opts ...jwt.ParserOption) (*jwt.Token, error) {
+	opts = append(opts, jwt.WithValidMethods(k.methods))
+
+	return jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (any, error) {
+		id, ok := t.Header["kid"].(string)
+		if !ok {
+			return nil, errors.New("token has no key id")
+		}
+
+		key, ok := k.keys[id]
+		if !ok {
+			return nil, fmt.Errorf("unknown key id '%s'", id)
+		}
+
+		if t.Method.Alg() != key.Method.Alg() {
+			return nil, fmt.Errorf("key '%s' is not used with '%s'", id, t.Method.Alg())
+		}
+
+		return key.VerifyKey, nil
+	}, opts...)
+}
+
+// PublicKeys returns the public parts of asymmetric keys, so other services can validate the tokens.
+func (k *Keyring) PublicKeys() []JSONWebKey {
+	result := make([]JSONWebKey, 0, len(k.keys))
+	for _, key := range k.keys {
+		if jwk, ok := key.JWK(); ok {
+			result = append(result, jwk)
+		}
+	}
+
+	slices.SortFunc(result, func(a, b JSONWebKey) int {
+		return strings.Compare(a.Kid, b.Kid)
+	})
+
+	return result
+}
+
+// NewKeyring creates a keyring, which signs tokens with the signing key
+// and validates tokens signed by any of the provided keys.
+func NewKeyring(signing SigningKey, verifying ...SigningKey) (*Keyring, error) {
+	if signing.SignKey == nil {
+		return nil, errors.New("signing key should have a private part")
+	}
+
+	k := &Keyring{
+		signing: signing.Id,
+		keys:    make(map[string]SigningKey, len(verifying)+1),
+	}
+
+	for _, key := range append([]SigningKey{signing}, verifying...) {
+		if key.Id == "" {
+			return nil, errors.New("signing key should have an id")
+		}
+		if _, ok := k.keys[key.Id]; ok {
+			return nil, fmt.Errorf("duplicate signing key id '%s'", key.Id)
+		}
+
+		k.keys[key.Id] = key
+		if !slices.Contains(k.methods, key.Method.Alg()) {
+			k.methods = append(k.methods, key.Method.Alg())
+		}
+	}
+
+	return k, nil
+}
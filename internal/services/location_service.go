@@ -0,0 +1,301 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+	"github.com/maxdikun/weatherapp/internal/repositories"
+)
+
+// LocationQuery selects the location of a weather request, either by the coordinates,
+// by the id of a saved location or by the id of a place found by GeoService.
+type LocationQuery struct {
+	Latitude   *float64
+	Longitude  *float64
+	LocationId *uuid.UUID
+	PlaceId    *string
+}
+
+// NewLocation is a location to save, it is placed either by the coordinates or by the id of a place.
+type NewLocation struct {
+	Name      string
+	Latitude  *float64
+	Longitude *float64
+	PlaceId   *string
+}
+
+// LocationUpdate contains changed fields of a location, nil fields are left as is.
+// PlaceId replaces the coordinates with the ones of the place.
+type LocationUpdate struct {
+	Name      *string
+	Latitude  *float64
+	Longitude *float64
+	PlaceId   *string
+}
+
+type LocationService struct {
+	logger *slog.Logger
+
+	locationStorage repositories.LocationRepository
+	geoSvc          *GeoService
+	maxLocations    int
+}
+
+// List returns saved locations of the user in their order.
+func (svc *LocationService) List(ctx context.Context, user uuid.UUID) ([]models.Location, error) {
+	locations, err := svc.locationStorage.FindByUser(ctx, user)
+	if err != nil {
+		svc.logger.Error("Failed to list locations", "user", user, "err", err)
+		return nil, ErrInternal
+	}
+	return locations, nil
+}
+
+// Get returns the saved location of the user.
+func (svc *LocationService) Get(ctx context.Context, user uuid.UUID, id uuid.UUID) (models.Location, error) {
+	location, err := svc.locationStorage.FindById(ctx, id)
+	if err != nil {
+		var notFound *repositories.NotFoundError
+		if errors.As(err, &notFound) {
+			return models.Location{}, ErrLocationNotFound
+		}
+		svc.logger.Error("Failed to find location", "location", id, "err", err)
+		return models.Location{}, ErrInternal
+	}
+
+	// Locations of other users should be indistinguishable from missing ones.
+	if location.User != user {
+		return models.Location{}, ErrLocationNotFound
+	}
+
+	return location, nil
+}
+
+// Add saves a new location after the other locations of the user.
+func (svc *LocationService) Add(ctx context.Context, user uuid.UUID, location NewLocation) (models.Location, error) {
+	name := strings.TrimSpace(location.Name)
+	coordinates, err := svc.placeCoordinates(ctx, location.Latitude, location.Longitude, location.PlaceId)
+	if err = errors.Join(validateLocationName(name), err); err != nil {
+		return models.Location{}, err
+	}
+
+	stored, err := svc.locationStorage.Add(ctx, models.Location{
+		Id:          uuid.New(),
+		User:        user,
+		Name:        name,
+		Coordinates: coordinates,
+		CreatedAt:   time.Now(),
+	}, svc.maxLocations)
+	if err != nil {
+		return models.Location{}, svc.storageError(err, "Failed to add location", "user", user)
+	}
+
+	return stored, nil
+}
+
+// Update changes the saved location of the user.
+func (svc *LocationService) Update(ctx context.Context, user uuid.UUID, id uuid.UUID, update LocationUpdate) (models.Location, error) {
+	location, err := svc.Get(ctx, user, id)
+	if err != nil {
+		return models.Location{}, err
+	}
+
+	if update.Name != nil {
+		location.Name = strings.TrimSpace(*update.Name)
+	}
+
+	latitude, longitude := update.Latitude, update.Longitude
+	if update.PlaceId == nil {
+		// Partial changes of the coordinates are applied to the current ones.
+		if latitude == nil {
+			latitude = &location.Coordinates.Latitude
+		}
+		if longitude == nil {
+			longitude = &location.Coordinates.Longitude
+		}
+	}
+
+	location.Coordinates, err = svc.placeCoordinates(ctx, latitude, longitude, update.PlaceId)
+	if err = errors.Join(validateLocationName(location.Name), err); err != nil {
+		return models.Location{}, err
+	}
+
+	if err := svc.locationStorage.Update(ctx, location); err != nil {
+		return models.Location{}, svc.storageError(err, "Failed to update location", "location", id)
+	}
+
+	return location, nil
+}
+
+// Reorder changes the order of the saved locations, ids should contain every location of the user.
+func (svc *LocationService) Reorder(ctx context.Context, user uuid.UUID, ids []uuid.UUID) ([]models.Location, error) {
+	locations, err := svc.List(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	byId := make(map[uuid.UUID]models.Location, len(locations))
+	for _, location := range locations {
+		byId[location.Id] = location
+	}
+
+	if len(ids) != len(locations) {
+		return nil, &ValidationError{Field: "ids", Message: "should contain every saved location exactly once"}
+	}
+	ordered := make([]models.Location, len(ids))
+	for i, id := range ids {
+		location, ok := byId[id]
+		if !ok {
+			return nil, &ValidationError{Field: "ids", Message: "should contain every saved location exactly once"}
+		}
+		delete(byId, id)
+
+		location.Position = i
+		ordered[i] = location
+	}
+
+	if err := svc.locationStorage.Reorder(ctx, user, ids); err != nil {
+		return nil, svc.storageError(err, "Failed to reorder locations", "user", user)
+	}
+
+	return ordered, nil
+}
+
+// Delete removes the saved location of the user.
+func (svc *LocationService) Delete(ctx context.Context, user uuid.UUID, id uuid.UUID) error {
+	if _, err := svc.Get(ctx, user, id); err != nil {
+		return err
+	}
+
+	if err := svc.locationStorage.Delete(ctx, id); err != nil {
+		return svc.storageError(err, "Failed to delete location", "location", id)
+	}
+
+	return nil
+}
+
+// Resolve returns the coordinates selected by the query.
+func (svc *LocationService) Resolve(ctx context.Context, user uuid.UUID, query LocationQuery) (models.Coordinates, error) {
+	if query.LocationId == nil {
+		return svc.coordinates(ctx, query.Latitude, query.Longitude, query.PlaceId, "lat", "lon", "place")
+	}
+
+	if query.Latitude != nil || query.Longitude != nil || query.PlaceId != nil {
+		return models.Coordinates{}, &ValidationError{Field: "location", Message: "should not be combined with lat, lon or place"}
+	}
+
+	location, err := svc.Get(ctx, user, *query.LocationId)
+	if err != nil {
+		return models.Coordinates{}, err
+	}
+	return location.Coordinates, nil
+}
+
+// placeCoordinates returns the coordinates of a saved location, which are given either directly or by a place.
+func (svc *LocationService) placeCoordinates(
+	ctx context.Context,
+	latitude *float64,
+	longitude *float64,
+	placeId *string,
+) (models.Coordinates, error) {
+	coordinates, err := svc.coordinates(ctx, latitude, longitude, placeId, "latitude", "longitude", "placeId")
+	if err != nil {
+		return models.Coordinates{}, err
+	}
+	return coordinates, validateCoordinateFields(coordinates, "latitude", "longitude")
+}
+
+// coordinates returns either the coordinates or the coordinates of the place,
+// the names of the fields are used in validation errors.
+func (svc *LocationService) coordinates(
+	ctx context.Context,
+	latitude *float64,
+	longitude *float64,
+	placeId *string,
+	latitudeField string,
+	longitudeField string,
+	placeField string,
+) (models.Coordinates, error) {
+	if placeId != nil {
+		if latitude != nil || longitude != nil {
+			return models.Coordinates{}, &ValidationError{
+				Field:   placeField,
+				Message: fmt.Sprintf("should not be combined with %s and %s", latitudeField, longitudeField),
+			}
+		}
+
+		place, err := svc.geoSvc.Place(ctx, *placeId)
+		if err != nil {
+			return models.Coordinates{}, err
+		}
+		return place.Coordinates, nil
+	}
+
+	var errs []error
+	if latitude == nil {
+		errs = append(errs, &ValidationError{Field: latitudeField, Message: "is required without " + placeField})
+	}
+	if longitude == nil {
+		errs = append(errs, &ValidationError{Field: longitudeField, Message: "is required without " + placeField})
+	}
+	if len(errs) != 0 {
+		return models.Coordinates{}, errors.Join(errs...)
+	}
+
+	return models.Coordinates{Latitude: *latitude, Longitude: *longitude}, nil
+}
+
+// storageError translates errors of the repository, unexpected ones are logged with the message and args.
+func (svc *LocationService) storageError(err error, msg string, args ...any) error {
+	var notFound *repositories.NotFoundError
+	if errors.As(err, &notFound) {
+		return ErrLocationNotFound
+	}
+	var alreadyExists *repositories.AlreadyExistsError
+	if errors.As(err, &alreadyExists) {
+		return &ConflictError{Object: "location", Field: alreadyExists.Field}
+	}
+	var limitExceeded *repositories.LimitExceededError
+	if errors.As(err, &limitExceeded) {
+		return &LimitError{Object: "location", Limit: limitExceeded.Limit}
+	}
+
+	svc.logger.Error(msg, append(args, "err", err)...)
+	return ErrInternal
+}
+
+func validateLocationName(name string) error {
+	if name == "" {
+		return &ValidationError{Field: "name", Message: "should not be empty"}
+	}
+	if utf8.RuneCountInString(name) > 100 {
+		return &ValidationError{Field: "name", Message: "should be at most 100 characters long"}
+	}
+	if strings.ContainsFunc(name, func(r rune) bool { return !unicode.IsPrint(r) }) {
+		return &ValidationError{Field: "name", Message: "should not contain control characters"}
+	}
+	return nil
+}
+
+func NewLocationService(
+	logger *slog.Logger,
+	locationStorage repositories.LocationRepository,
+	geoSvc *GeoService,
+	maxLocations int,
+) *LocationService {
+	return &LocationService{
+		logger:          logger,
+		locationStorage: locationStorage,
+		geoSvc:          geoSvc,
+		maxLocations:    maxLocations,
+	}
+}
@@ -0,0 +1,83 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/maxdikun/weatherapp/internal/repositories"
+)
+
+// LoginGuard protects authentication from brute-force and credential stuffing.
+//
+// Failed attempts are counted per login and per client IP, after the limit is reached
+// the key is locked out, and every next failure doubles the lockout up to the maximum.
+// Attempts are counted before the verification and undone if they don't fail.
+type LoginGuard struct {
+	attempts repositories.LoginAttemptRepository
+
+	window      time.Duration
+	maxPerLogin int
+	maxPerIP    int
+	baseLockout time.Duration
+	maxLockout  time.Duration
+}
+
+// Attempt counts the attempt before the credentials are verified, so the parallel attempts
+// can't exceed the limit. It returns *LockedError if either the login or the IP is locked out.
+// Every counted attempt, which hasn't failed, should be finished with Succeed or Abort.
+func (g *LoginGuard) Attempt(ctx context.Context, login string, ip string) error {
+	lockedFor, err := g.attempts.Attempt(ctx, g.keys(login, ip), g.window, g.baseLockout, g.maxLockout)
+	if err != nil {
+		return err
+	}
+	if lockedFor > 0 {
+		return &LockedError{RetryAfter: lockedFor}
+	}
+	return nil
+}
+
+// Succeed resets the counter of the login and undoes the attempt of the IP.
+// The earlier failures of the IP are kept, otherwise an attacker could reset them with their own account.
+func (g *LoginGuard) Succeed(ctx context.Context, login string, ip string) error {
+	err := g.attempts.Reset(ctx, "login:"+login)
+	if ip != "" {
+		err = errors.Join(err, g.attempts.Release(ctx, "ip:"+ip))
+	}
+	return err
+}
+
+// Abort undoes the attempt, which was neither successful nor failed, e.g. because of an internal error.
+func (g *LoginGuard) Abort(ctx context.Context, login string, ip string) error {
+	var errs []error
+	for _, key := range g.keys(login, ip) {
+		errs = append(errs, g.attempts.Release(ctx, key.Name))
+	}
+	return errors.Join(errs...)
+}
+
+func (g *LoginGuard) keys(login string, ip string) []repositories.AttemptKey {
+	keys := []repositories.AttemptKey{{Name: "login:" + login, Limit: g.maxPerLogin}}
+	if ip != "" {
+		keys = append(keys, repositories.AttemptKey{Name: "ip:" + ip, Limit: g.maxPerIP})
+	}
+	return keys
+}
+
+func NewLoginGuard(
+	attempts repositories.LoginAttemptRepository,
+	window time.Duration,
+	maxPerLogin int,
+	maxPerIP int,
+	baseLockout time.Duration,
+	maxLockout time.Duration,
+) *LoginGuard {
+	return &LoginGuard{
+		attempts:    attempts,
+		window:      window,
+		maxPerLogin: maxPerLogin,
+		maxPerIP:    maxPerIP,
+		baseLockout: baseLockout,
+		maxLockout:  maxLockout,
+	}
+}
@@ -0,0 +1,178 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+	"github.com/maxdikun/weatherapp/internal/notifications"
+	"github.com/maxdikun/weatherapp/internal/repositories"
+)
+
+// NotificationDispatcher delivers the notifications from the outbox to the channels configured by the users.
+// Claimed notifications are leased, so several dispatchers don't deliver the same notification at once,
+// and the ones left by a crashed dispatcher are retried after the lease expires. Therefore a notification
+// can be delivered more than once, receivers can use its id to skip duplicates.
+type NotificationDispatcher struct {
+	logger *slog.Logger
+
+	notificationStorage repositories.NotificationRepository
+	channels            []notifications.Channel
+
+	interval        time.Duration
+	lease           time.Duration
+	batchSize       int
+	concurrency     int
+	maxAttempts     int
+	retryBackoff    time.Duration
+	maxRetryBackoff time.Duration
+}
+
+// Run dispatches the due notifications every interval until the context is canceled.
+func (d *NotificationDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		d.Dispatch(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Dispatch delivers the due notifications, batches are claimed until the outbox has no due ones.
+func (d *NotificationDispatcher) Dispatch(ctx context.Context) {
+	for ctx.Err() == nil {
+		now := time.Now()
+		batch, err := d.notificationStorage.Claim(ctx, now, now.Add(d.lease), d.batchSize)
+		if err != nil {
+			if ctx.Err() == nil {
+				d.logger.Error("Failed to claim notifications", "err", err)
+			}
+			return
+		}
+
+		var group errgroup.Group
+		group.SetLimit(d.concurrency)
+		for _, notification := range batch {
+			group.Go(func() error {
+				d.deliver(ctx, notification)
+				return nil
+			})
+		}
+		_ = group.Wait()
+
+		if len(batch) < d.batchSize {
+			return
+		}
+	}
+}
+
+func (d *NotificationDispatcher) deliver(ctx context.Context, notification models.Notification) {
+	settings, err := d.notificationStorage.FindSettings(ctx, notification.User)
+	var notFound *repositories.NotFoundError
+	if err != nil && !errors.As(err, &notFound) {
+		// The attempt was interrupted by the shutdown, so it's retried when the lease expires.
+		if ctx.Err() != nil {
+			return
+		}
+		d.logger.Error("Failed to find notification settings", "user", notification.User, "err", err)
+		d.finish(ctx, notification, []error{fmt.Errorf("settings: %w", err)})
+		return
+	}
+
+	var errs []error
+	for _, channel := range d.channels {
+		name := channel.Name()
+		if slices.Contains(notification.Delivered, name) || !channel.Accepts(settings, notification) {
+			continue
+		}
+
+		if err := channel.Send(ctx, settings, notification); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+			continue
+		}
+
+		// The channel won't receive the notification again, when the others are retried.
+		if err := d.notificationStorage.MarkDelivered(ctx, notification.Id, name); err != nil {
+			d.logger.Error("Failed to mark notification as delivered",
+				"notification", notification.Id, "channel", name, "err", err)
+		}
+	}
+
+	// The attempt was interrupted by the shutdown, so it's retried when the lease expires.
+	if ctx.Err() != nil {
+		return
+	}
+
+	d.finish(ctx, notification, errs)
+}
+
+// finish records the result of the attempt, the failed notification is retried until it has been attempted maxAttempts times.
+func (d *NotificationDispatcher) finish(ctx context.Context, notification models.Notification, errs []error) {
+	var err error
+	now := time.Now()
+	switch {
+	case len(errs) == 0:
+		err = d.notificationStorage.Complete(ctx, notification.Id, now)
+	case notification.Attempts >= d.maxAttempts:
+		d.logger.Warn("Giving up on notification",
+			"notification", notification.Id, "attempts", notification.Attempts, "err", errors.Join(errs...))
+		err = d.notificationStorage.Fail(ctx, notification.Id, now, errors.Join(errs...).Error())
+	default:
+		d.logger.Warn("Failed to deliver notification",
+			"notification", notification.Id, "attempts", notification.Attempts, "err", errors.Join(errs...))
+		err = d.notificationStorage.Retry(ctx, notification.Id, now.Add(d.backoff(notification.Attempts)), errors.Join(errs...).Error())
+	}
+	if err != nil {
+		d.logger.Error("Failed to record notification delivery", "notification", notification.Id, "err", err)
+	}
+}
+
+// backoff returns the delay after the failed attempt, it doubles with every attempt up to maxRetryBackoff.
+func (d *NotificationDispatcher) backoff(attempts int) time.Duration {
+	backoff := d.retryBackoff
+	for i := 1; i < attempts && backoff < d.maxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, d.maxRetryBackoff)
+}
+
+// NewNotificationDispatcher creates the dispatcher, which claims at most batchSize notifications at once
+// and delivers at most concurrency of them in parallel. A notification is retried with exponential backoff
+// starting from retryBackoff, until it has been attempted maxAttempts times.
+func NewNotificationDispatcher(
+	logger *slog.Logger,
+	notificationStorage repositories.NotificationRepository,
+	channels []notifications.Channel,
+	interval time.Duration,
+	lease time.Duration,
+	batchSize int,
+	concurrency int,
+	maxAttempts int,
+	retryBackoff time.Duration,
+	maxRetryBackoff time.Duration,
+) *NotificationDispatcher {
+	return &NotificationDispatcher{
+		logger:              logger,
+		notificationStorage: notificationStorage,
+		channels:            channels,
+		interval:            interval,
+		lease:               lease,
+		batchSize:           batchSize,
+		concurrency:         concurrency,
+		maxAttempts:         maxAttempts,
+		retryBackoff:        retryBackoff,
+		maxRetryBackoff:     maxRetryBackoff,
+	}
+}
@@ -0,0 +1,309 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+	"github.com/maxdikun/weatherapp/internal/repositories"
+)
+
+// emailVerificationDuration is the time, during which the code sent to the new email can be confirmed.
+const emailVerificationDuration = 24 * time.Hour
+
+// NotificationSettingsUpdate contains changed settings, nil fields are left as is and empty strings disable the channels.
+type NotificationSettingsUpdate struct {
+	Email      *string
+	WebhookURL *string
+}
+
+// NotificationService manages the notification settings of the users and enqueues notifications,
+// which are delivered by NotificationDispatcher.
+type NotificationService struct {
+	logger *slog.Logger
+
+	notificationStorage repositories.NotificationRepository
+}
+
+// Settings returns the settings of the user, every channel is disabled if they were never changed.
+func (svc *NotificationService) Settings(ctx context.Context, user uuid.UUID) (models.NotificationSettings, error) {
+	settings, err := svc.notificationStorage.FindSettings(ctx, user)
+	if err != nil {
+		var notFound *repositories.NotFoundError
+		if errors.As(err, &notFound) {
+			return models.NotificationSettings{User: user}, nil
+		}
+		svc.logger.Error("Failed to find notification settings", "user", user, "err", err)
+		return models.NotificationSettings{}, ErrInternal
+	}
+
+	return settings, nil
+}
+
+// UpdateSettings changes the settings of the user. A new webhook secret is generated whenever the URL changes,
+// so a secret is never shared between receivers. A verification code is sent to a new or still unverified email,
+// and the notifications are sent to it only after VerifyEmail.
+func (svc *NotificationService) UpdateSettings(ctx context.Context, user uuid.UUID, update NotificationSettingsUpdate) (models.NotificationSettings, error) {
+	settings, err := svc.Settings(ctx, user)
+	if err != nil {
+		return models.NotificationSettings{}, err
+	}
+
+	var verificationCode string
+	if update.Email != nil {
+		email := strings.TrimSpace(*update.Email)
+		if email != settings.Email || !settings.EmailVerified {
+			settings.Email = email
+			settings.EmailVerified = false
+			settings.EmailVerificationHash = ""
+			settings.EmailVerificationExpiresAt = time.Time{}
+			if email != "" {
+				verificationCode = newEmailVerificationCode()
+				settings.EmailVerificationHash = hashEmailVerificationCode(verificationCode)
+				settings.EmailVerificationExpiresAt = time.Now().Add(emailVerificationDuration)
+			}
+		}
+	}
+	if update.WebhookURL != nil {
+		webhookURL := strings.TrimSpace(*update.WebhookURL)
+		if webhookURL != settings.WebhookURL {
+			settings.WebhookURL = webhookURL
+			settings.WebhookSecret = ""
+			if webhookURL != "" {
+				settings.WebhookSecret = newWebhookSecret()
+			}
+		}
+	}
+
+	if err := errors.Join(validateEmail(settings.Email), validateWebhookURL(settings.WebhookURL)); err != nil {
+		return models.NotificationSettings{}, err
+	}
+
+	if err := svc.notificationStorage.SaveSettings(ctx, settings); err != nil {
+		svc.logger.Error("Failed to save notification settings", "user", user, "err", err)
+		return models.NotificationSettings{}, ErrInternal
+	}
+
+	if verificationCode != "" {
+		svc.Notify(ctx, emailVerificationNotification(user, settings.Email, verificationCode, settings.EmailVerificationExpiresAt))
+	}
+
+	return settings, nil
+}
+
+// VerifyEmail confirms the email of the user with the code sent to it by UpdateSettings.
+func (svc *NotificationService) VerifyEmail(ctx context.Context, user uuid.UUID, code string) (models.NotificationSettings, error) {
+	settings, err := svc.Settings(ctx, user)
+	if err != nil {
+		return models.NotificationSettings{}, err
+	}
+
+	if settings.EmailVerified {
+		return settings, nil
+	}
+	hash := hashEmailVerificationCode(strings.ToUpper(strings.TrimSpace(code)))
+	if settings.EmailVerificationHash == "" ||
+		subtle.ConstantTimeCompare([]byte(hash), []byte(settings.EmailVerificationHash)) != 1 ||
+		time.Now().After(settings.EmailVerificationExpiresAt) {
+		return models.NotificationSettings{}, &ValidationError{Field: "code", Message: "is invalid or expired"}
+	}
+
+	settings.EmailVerified = true
+	settings.EmailVerificationHash = ""
+	settings.EmailVerificationExpiresAt = time.Time{}
+	if err := svc.notificationStorage.SaveSettings(ctx, settings); err != nil {
+		svc.logger.Error("Failed to save notification settings", "user", user, "err", err)
+		return models.NotificationSettings{}, ErrInternal
+	}
+
+	return settings, nil
+}
+
+// Enqueue enqueues the notification, which the operation can't succeed without.
+func (svc *NotificationService) Enqueue(ctx context.Context, notification models.Notification) error {
+	if err := svc.notificationStorage.Add(ctx, notification); err != nil {
+		svc.logger.Error("Failed to enqueue notification",
+			"user", notification.User, "kind", notification.Kind, "err", err)
+		return ErrInternal
+	}
+	return nil
+}
+
+// Notify enqueues the notification. Failure is only logged, because the notification
+// is a side effect of an operation, which has already succeeded.
+func (svc *NotificationService) Notify(ctx context.Context, notification models.Notification) {
+	if err := svc.notificationStorage.Add(ctx, notification); err != nil {
+		svc.logger.Error("Failed to enqueue notification",
+			"user", notification.User, "kind", notification.Kind, "err", err)
+	}
+}
+
+func validateEmail(email string) error {
+	if email == "" {
+		return nil
+	}
+	if len(email) > 254 {
+		return &ValidationError{Field: "email", Message: "should be at most 254 bytes long"}
+	}
+	// Only a bare address is accepted, the display name is not used.
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email {
+		return &ValidationError{Field: "email", Message: "should be an email address"}
+	}
+	return nil
+}
+
+func validateWebhookURL(webhookURL string) error {
+	if webhookURL == "" {
+		return nil
+	}
+	if len(webhookURL) > 2048 {
+		return &ValidationError{Field: "webhookUrl", Message: "should be at most 2048 bytes long"}
+	}
+	parsed, err := url.Parse(webhookURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return &ValidationError{Field: "webhookUrl", Message: "should be an absolute http or https URL"}
+	}
+	if parsed.User != nil {
+		return &ValidationError{Field: "webhookUrl", Message: "should not contain credentials"}
+	}
+	return nil
+}
+
+// newWebhookSecret generates a random secret with 256 bits of entropy.
+func newWebhookSecret() string {
+	b := make([]byte, 32)
+	// Read never returns an error, it crashes the program if the system randomness fails.
+	_, _ = rand.Read(b)
+	return "whsec_" + base64.RawURLEncoding.EncodeToString(b)
+}
+
+// newEmailVerificationCode generates a random code with 80 bits of entropy, which is easy to type.
+func newEmailVerificationCode() string {
+	b := make([]byte, 10)
+	// Read never returns an error, it crashes the program if the system randomness fails.
+	_, _ = rand.Read(b)
+	return base32.StdEncoding.EncodeToString(b)
+}
+
+// hashEmailVerificationCode hashes the code, so the stored settings can't be used to verify the email.
+func hashEmailVerificationCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+func newNotification(user uuid.UUID, kind models.NotificationKind, title string, message string, data map[string]any) models.Notification {
+	return models.Notification{
+		Id:        uuid.New(),
+		User:      user,
+		Kind:      kind,
+		Title:     title,
+		Message:   message,
+		Data:      data,
+		CreatedAt: time.Now(),
+	}
+}
+
+// alertMetricNames are used in the messages, the values are in the units of models.HourlyForecast.
+var alertMetricNames = map[models.AlertMetric][2]string{
+	models.AlertTemperature:              {"Temperature", "°C"},
+	models.AlertFeelsLike:                {"Feels-like temperature", "°C"},
+	models.AlertHumidity:                 {"Humidity", "%"},
+	models.AlertWindSpeed:                {"Wind speed", " m/s"},
+	models.AlertWindGust:                 {"Wind gusts", " m/s"},
+	models.AlertPrecipitation:            {"Precipitation", " mm"},
+	models.AlertPrecipitationProbability: {"Precipitation probability", "%"},
+}
+
+func alertNotification(target models.AlertTarget, alert models.Alert) models.Notification {
+	name := alertMetricNames[alert.Metric]
+	forecastTime := alert.ForecastTime.In(target.Location())
+
+	return newNotification(
+		alert.User,
+		models.NotificationAlert,
+		fmt.Sprintf("Weather alert for %s", target.LocationName),
+		fmt.Sprintf("%s in %s is forecast to be %s %g%s: %g%s at %s.",
+			name[0], target.LocationName, alert.Operator, alert.Threshold, name[1],
+			alert.Value, name[1], forecastTime.Format("Mon, 02 Jan 15:04 MST")),
+		map[string]any{
+			"alertId":        alert.Id,
+			"subscriptionId": alert.Subscription,
+			"locationId":     alert.Location,
+			"locationName":   target.LocationName,
+			"metric":         alert.Metric,
+			"operator":       alert.Operator,
+			"threshold":      alert.Threshold,
+			"value":          alert.Value,
+			"forecastTime":   alert.ForecastTime,
+		},
+	)
+}
+
+func newLoginNotification(user uuid.UUID, session models.Session) models.Notification {
+	return newNotification(
+		user,
+		models.NotificationNewLogin,
+		"New login to your account",
+		fmt.Sprintf("Your account was signed in at %s from %s (%s). If it wasn't you, change your password.",
+			session.CreatedAt.UTC().Format(time.RFC1123), session.IP, session.UserAgent),
+		map[string]any{
+			"sessionId":  session.Id,
+			"ip":         session.IP,
+			"userAgent":  session.UserAgent,
+			"loggedInAt": session.CreatedAt,
+		},
+	)
+}
+
+func passwordChangedNotification(user uuid.UUID, client ClientInfo, at time.Time) models.Notification {
+	return newNotification(
+		user,
+		models.NotificationPasswordChanged,
+		"Your password was changed",
+		fmt.Sprintf("The password of your account was changed at %s from %s (%s). If it wasn't you, contact support.",
+			at.UTC().Format(time.RFC1123), client.IP, client.UserAgent),
+		map[string]any{
+			"ip":        client.IP,
+			"userAgent": client.UserAgent,
+			"changedAt": at,
+		},
+	)
+}
+
+// emailVerificationNotification contains the code in the message only, so it isn't sent to the other channels,
+// and the message is cleared, when the notification leaves the queue.
+func emailVerificationNotification(user uuid.UUID, email string, code string, expiresAt time.Time) models.Notification {
+	return newNotification(
+		user,
+		models.NotificationEmailVerification,
+		"Confirm your email",
+		fmt.Sprintf("Use the code %s to confirm that %s receives the weather notifications. The code is valid until %s.",
+			code, email, expiresAt.UTC().Format(time.RFC1123)),
+		map[string]any{
+			"email":     email,
+			"expiresAt": expiresAt,
+		},
+	)
+}
+
+func NewNotificationService(logger *slog.Logger, notificationStorage repositories.NotificationRepository) *NotificationService {
+	return &NotificationService{
+		logger:              logger,
+		notificationStorage: notificationStorage,
+	}
+}
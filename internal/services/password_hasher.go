@@ -0,0 +1,228 @@
+package services
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var errUnsupportedHash = errors.New("password hash is produced by unsupported algorithm")
+
+// PasswordHasher hashes passwords and verifies them against stored hashes.
+type PasswordHasher interface {
+	// Hash returns the hash of the password encoded in PHC string format.
+	Hash(password string) (string, error)
+	// Verify checks the password against the encoded hash.
+	Verify(password string, encoded string) (bool, error)
+	// NeedsRehash reports whether the encoded hash was produced by another algorithm
+	// or with weaker parameters than the hasher uses now.
+	NeedsRehash(encoded string) bool
+}
+
+// Argon2idHasher hashes passwords with argon2id.
+type Argon2idHasher struct {
+	Memory      uint32 // in KiB
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+var _ PasswordHasher = (*Argon2idHasher)(nil)
+
+// Validate checks the parameters, argon2 panics if the iterations or the parallelism are zero.
+func (h *Argon2idHasher) Validate() error {
+	if err := validateArgon2idParams(h.Memory, h.Iterations, h.Parallelism); err != nil {
+		return err
+	}
+	if h.SaltLength < 8 {
+		return errors.New("argon2id salt should be at least 8 bytes long")
+	}
+	if h.KeyLength < 16 {
+		return errors.New("argon2id key should be at least 16 bytes long")
+	}
+	return nil
+}
+
+// Hash implements PasswordHasher.
+func (h *Argon2idHasher) Hash(password string) (string, error) {
+	salt := make([]byte, h.SaltLength)
+	_, _ = rand.Read(salt)
+
+	key := argon2.IDKey([]byte(password), salt, h.Iterations, h.Memory, h.Parallelism, h.KeyLength)
+
+	return fmt.Sprintf(
+		"$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version,
+		h.Memory,
+		h.Iterations,
+		h.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// Verify implements PasswordHasher.
+func (h *Argon2idHasher) Verify(password string, encoded string) (bool, error) {
+	params, salt, key, err := decodeArgon2id(encoded)
+	if err != nil {
+		return false, err
+	}
+
+	actual := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, uint32(len(key)))
+	return subtle.ConstantTimeCompare(actual, key) == 1, nil
+}
+
+// NeedsRehash implements PasswordHasher.
+func (h *Argon2idHasher) NeedsRehash(encoded string) bool {
+	params, _, key, err := decodeArgon2id(encoded)
+	if err != nil {
+		return true
+	}
+
+	return params.Memory < h.Memory || params.Iterations < h.Iterations || uint32(len(key)) < h.KeyLength
+}
+
+func decodeArgon2id(encoded string) (Argon2idHasher, []byte, []byte, error) {
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return Argon2idHasher{}, nil, nil, errUnsupportedHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return Argon2idHasher{}, nil, nil, fmt.Errorf("invalid argon2id version: %w", err)
+	}
+	if version != argon2.Version {
+		return Argon2idHasher{}, nil, nil, errUnsupportedHash
+	}
+
+	var params Argon2idHasher
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return Argon2idHasher{}, nil, nil, fmt.Errorf("invalid argon2id parameters: %w", err)
+	}
+	if err := validateArgon2idParams(params.Memory, params.Iterations, params.Parallelism); err != nil {
+		return Argon2idHasher{}, nil, nil, fmt.Errorf("invalid argon2id parameters: %w", err)
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return Argon2idHasher{}, nil, nil, fmt.Errorf("invalid argon2id salt: %w", err)
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return Argon2idHasher{}, nil, nil, fmt.Errorf("invalid argon2id key: %w", err)
+	}
+
+	return params, salt, key, nil
+}
+
+func validateArgon2idParams(memory uint32, iterations uint32, parallelism uint8) error {
+	if iterations < 1 {
+		return errors.New("argon2id iterations should be at least 1")
+	}
+	if parallelism < 1 {
+		return errors.New("argon2id parallelism should be at least 1")
+	}
+	if memory < 8*uint32(parallelism) {
+		return errors.New("argon2id memory should be at least 8 KiB per thread")
+	}
+	return nil
+}
+
+// BcryptHasher hashes passwords with bcrypt.
+type BcryptHasher struct {
+	Cost int
+}
+
+var _ PasswordHasher = (*BcryptHasher)(nil)
+
+// Validate checks the cost, otherwise bcrypt replaces a too low one with the default
+// and rejects a too high one only when hashing.
+func (h *BcryptHasher) Validate() error {
+	if h.Cost < bcrypt.MinCost || h.Cost > bcrypt.MaxCost {
+		return fmt.Errorf("bcrypt cost should be between %d and %d", bcrypt.MinCost, bcrypt.MaxCost)
+	}
+	return nil
+}
+
+// Hash implements PasswordHasher.
+func (h *BcryptHasher) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), h.Cost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// Verify implements PasswordHasher.
+func (h *BcryptHasher) Verify(password string, encoded string) (bool, error) {
+	if !isBcrypt(encoded) {
+		return false, errUnsupportedHash
+	}
+
+	err := bcrypt.CompareHashAndPassword([]byte(encoded), []byte(password))
+	if err != nil {
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// NeedsRehash implements PasswordHasher.
+func (h *BcryptHasher) NeedsRehash(encoded string) bool {
+	if !isBcrypt(encoded) {
+		return true
+	}
+
+	cost, err := bcrypt.Cost([]byte(encoded))
+	return err != nil || cost < h.Cost
+}
+
+func isBcrypt(encoded string) bool {
+	return strings.HasPrefix(encoded, "$2a$") || strings.HasPrefix(encoded, "$2b$") || strings.HasPrefix(encoded, "$2y$")
+}
+
+// FallbackHasher hashes passwords with the primary hasher,
+// but still verifies the hashes produced by the previously used ones.
+type FallbackHasher struct {
+	primary   PasswordHasher
+	fallbacks []PasswordHasher
+}
+
+var _ PasswordHasher = (*FallbackHasher)(nil)
+
+// Hash implements PasswordHasher.
+func (h *FallbackHasher) Hash(password string) (string, error) {
+	return h.primary.Hash(password)
+}
+
+// Verify implements PasswordHasher.
+func (h *FallbackHasher) Verify(password string, encoded string) (bool, error) {
+	for _, hasher := range append([]PasswordHasher{h.primary}, h.fallbacks...) {
+		ok, err := hasher.Verify(password, encoded)
+		if errors.Is(err, errUnsupportedHash) {
+			continue
+		}
+		return ok, err
+	}
+	return false, errUnsupportedHash
+}
+
+// NeedsRehash implements PasswordHasher.
+func (h *FallbackHasher) NeedsRehash(encoded string) bool {
+	return h.primary.NeedsRehash(encoded)
+}
+
+func NewFallbackHasher(primary PasswordHasher, fallbacks ...PasswordHasher) *FallbackHasher {
+	return &FallbackHasher{primary: primary, fallbacks: fallbacks}
+}
@@ -0,0 +1,114 @@
+package services
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// PasswordRule checks one requirement for the password of the user with the login.
+// It returns *ValidationError if the requirement is not met.
+type PasswordRule func(login string, password string) error
+
+// PasswordPolicy is a set of rules, which every new password must satisfy.
+type PasswordPolicy struct {
+	rules []PasswordRule
+}
+
+// Validate checks the password with every rule and returns all the failures joined.
+func (p *PasswordPolicy) Validate(login string, password string) error {
+	errs := make([]error, 0, len(p.rules))
+	for _, rule := range p.rules {
+		errs = append(errs, rule(login, password))
+	}
+	return errors.Join(errs...)
+}
+
+func NewPasswordPolicy(rules ...PasswordRule) *PasswordPolicy {
+	return &PasswordPolicy{rules: rules}
+}
+
+// MinLengthRule requires the password to contain at least n characters.
+func MinLengthRule(n int) PasswordRule {
+	return func(login string, password string) error {
+		if utf8.RuneCountInString(password) < n {
+			return &ValidationError{Field: "password", Message: fmt.Sprintf("should be at least %d characters long", n)}
+		}
+		return nil
+	}
+}
+
+// MaxBytesRule limits the size of the password in bytes.
+// bcrypt ignores everything after 72 bytes, so longer passwords give a false sense of security.
+func MaxBytesRule(n int) PasswordRule {
+	return func(login string, password string) error {
+		if len(password) > n {
+			return &ValidationError{Field: "password", Message: fmt.Sprintf("should be at most %d bytes long", n)}
+		}
+		return nil
+	}
+}
+
+// CharacterClassRule requires the password to contain at least one character of the class.
+func CharacterClassRule(name string, class func(r rune) bool) PasswordRule {
+	return func(login string, password string) error {
+		if !strings.ContainsFunc(password, class) {
+			return &ValidationError{Field: "password", Message: fmt.Sprintf("should contain at least one %s", name)}
+		}
+		return nil
+	}
+}
+
+var (
+	LowercaseRule = CharacterClassRule("lowercase letter", unicode.IsLower)
+	UppercaseRule = CharacterClassRule("uppercase letter", unicode.IsUpper)
+	DigitRule     = CharacterClassRule("digit", unicode.IsDigit)
+	SymbolRule    = CharacterClassRule("symbol", func(r rune) bool {
+		return unicode.IsPunct(r) || unicode.IsSymbol(r)
+	})
+)
+
+// NoLoginRule forbids the password to contain the login.
+func NoLoginRule(login string, password string) error {
+	if login != "" && strings.Contains(strings.ToLower(password), strings.ToLower(login)) {
+		return &ValidationError{Field: "password", Message: "should not contain the login"}
+	}
+	return nil
+}
+
+// BreachedPasswordRule forbids passwords which are present in the list of breached passwords.
+func BreachedPasswordRule(breached BreachedPasswords) PasswordRule {
+	return func(login string, password string) error {
+		if _, ok := breached[password]; ok {
+			return &ValidationError{Field: "password", Message: "is known to be breached, choose another one"}
+		}
+		return nil
+	}
+}
+
+// BreachedPasswords is a set of passwords, which were exposed in data breaches.
+type BreachedPasswords map[string]struct{}
+
+// ReadBreachedPasswords reads the list with one password per line.
+// Empty lines and lines starting with '#' are skipped.
+func ReadBreachedPasswords(r io.Reader) (BreachedPasswords, error) {
+	breached := make(BreachedPasswords)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		breached[line] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return breached, nil
+}
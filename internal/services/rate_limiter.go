@@ -0,0 +1,135 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/maxdikun/weatherapp/internal/repositories"
+)
+
+// RateLimit allows Requests during Period, zero value means no limit.
+type RateLimit struct {
+	Requests int
+	Period   time.Duration
+}
+
+// ParseRateLimit parses the limit in the "<requests>/<period>" format, e.g. "100/1m".
+func ParseRateLimit(s string) (RateLimit, error) {
+	requests, period, ok := strings.Cut(s, "/")
+	if !ok {
+		return RateLimit{}, fmt.Errorf("rate limit '%s' should be in '<requests>/<period>' format", s)
+	}
+
+	n, err := strconv.Atoi(requests)
+	if err != nil || n < 0 {
+		return RateLimit{}, fmt.Errorf("rate limit '%s' has invalid number of requests", s)
+	}
+
+	d, err := time.ParseDuration(period)
+	if err != nil || d <= 0 {
+		return RateLimit{}, fmt.Errorf("rate limit '%s' has invalid period", s)
+	}
+
+	return RateLimit{Requests: n, Period: d}, nil
+}
+
+func (l RateLimit) bucket(key string) repositories.TokenBucket {
+	return repositories.TokenBucket{
+		Key:   key,
+		Rate:  float64(l.Requests) / l.Period.Seconds(),
+		Burst: l.Requests,
+	}
+}
+
+// RateLimitStatus describes the most restrictive budget applied to the request.
+type RateLimitStatus struct {
+	Limit     int
+	Remaining int
+	Reset     time.Duration
+}
+
+// RateLimiter throttles requests with token buckets per client IP, per user and per route.
+type RateLimiter struct {
+	buckets repositories.RateLimitRepository
+
+	perIP    RateLimit
+	perUser  RateLimit
+	perRoute map[string]RateLimit
+}
+
+// Allow takes a token from every budget applicable to the request, if none of them is exhausted.
+// *RateLimitError is returned otherwise, and no token is taken, so a denied request doesn't spend the other budgets.
+// Route budgets are counted separately for every user, or for every IP if the request is anonymous.
+func (l *RateLimiter) Allow(ctx context.Context, route string, ip string, user uuid.UUID) (RateLimitStatus, error) {
+	type budget struct {
+		key   string
+		limit RateLimit
+	}
+
+	subject := "ip:" + ip
+	budgets := []budget{{key: subject, limit: l.perIP}}
+	if user != uuid.Nil {
+		subject = "user:" + user.String()
+		budgets = append(budgets, budget{key: subject, limit: l.perUser})
+	}
+	if limit, ok := l.perRoute[route]; ok {
+		budgets = append(budgets, budget{key: "route:" + route + ":" + subject, limit: limit})
+	}
+
+	var limits []RateLimit
+	var buckets []repositories.TokenBucket
+	for _, b := range budgets {
+		if b.limit.Requests == 0 {
+			continue
+		}
+		limits = append(limits, b.limit)
+		buckets = append(buckets, b.limit.bucket(b.key))
+	}
+	if len(buckets) == 0 {
+		return RateLimitStatus{}, nil
+	}
+
+	states, err := l.buckets.Take(ctx, buckets)
+	if err != nil {
+		return RateLimitStatus{}, err
+	}
+
+	var status RateLimitStatus
+	var retryAfter time.Duration
+	for i, state := range states {
+		if status.Limit == 0 || state.Remaining < status.Remaining {
+			status = RateLimitStatus{
+				Limit:     limits[i].Requests,
+				Remaining: state.Remaining,
+				Reset:     state.Reset,
+			}
+		}
+		if !state.Allowed {
+			retryAfter = max(retryAfter, state.RetryAfter)
+		}
+	}
+
+	if retryAfter > 0 {
+		return status, &RateLimitError{RetryAfter: retryAfter}
+	}
+	return status, nil
+}
+
+func NewRateLimiter(
+	buckets repositories.RateLimitRepository,
+	perIP RateLimit,
+	perUser RateLimit,
+	perRoute map[string]RateLimit,
+) *RateLimiter {
+	return &RateLimiter{
+		buckets:  buckets,
+		perIP:    perIP,
+		perUser:  perUser,
+		perRoute: perRoute,
+	}
+}
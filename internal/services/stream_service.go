@@ -0,0 +1,418 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+	"github.com/maxdikun/weatherapp/internal/repositories"
+)
+
+// streamResubscribeDelay is the pause between the attempts to subscribe to the events.
+const streamResubscribeDelay = 5 * time.Second
+
+// StreamUpdate is pushed to the streams subscribed to its location.
+type StreamUpdate struct {
+	Kind     models.EventKind
+	Location models.Location
+	// Weather is set for models.EventWeather, Alert for models.EventAlert.
+	Weather models.CurrentWeather
+	Alert   models.Alert
+}
+
+// StreamService pushes the weather updates and the triggered alerts to the streams of the connected clients.
+//
+// Every replica receives the events published by the others and fans them out to its own streams.
+// Weather events are matched to the locations by the coordinates rounded to the precision,
+// which should be the precision of the cache, because it publishes the rounded coordinates.
+type StreamService struct {
+	logger *slog.Logger
+
+	events          repositories.EventRepository
+	locationSvc     *LocationService
+	weatherSvc      *WeatherService
+	precision       int
+	bufferSize      int
+	refreshInterval time.Duration
+
+	mu      sync.RWMutex
+	streams map[*Stream]struct{}
+	closed  bool
+	// open counts the streams, which aren't released by Close yet.
+	open sync.WaitGroup
+}
+
+// Run receives the events until the context is canceled, then closes every stream with ErrStreamClosed
+// and waits until they are released, so the handlers can tell the clients why the connection is closed.
+func (svc *StreamService) Run(ctx context.Context) {
+	for ctx.Err() == nil {
+		err := svc.events.Subscribe(ctx, svc.dispatch)
+		if err == nil {
+			break
+		}
+		if ctx.Err() == nil {
+			svc.logger.Error("Failed to subscribe to events", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(streamResubscribeDelay):
+		}
+	}
+	<-ctx.Done()
+
+	svc.mu.Lock()
+	streams := svc.streams
+	svc.streams = nil
+	svc.closed = true
+	svc.mu.Unlock()
+
+	for stream := range streams {
+		stream.close(ErrStreamClosed)
+	}
+	svc.open.Wait()
+}
+
+// Open creates the stream of the user, it isn't subscribed to any location.
+// The weather of its locations is refreshed every refresh interval until the context is canceled
+// or the stream is closed. The stream should be closed when the client disconnects.
+func (svc *StreamService) Open(ctx context.Context, user uuid.UUID) (*Stream, error) {
+	stream := &Stream{
+		svc:        svc,
+		user:       user,
+		updates:    make(chan StreamUpdate, svc.bufferSize),
+		done:       make(chan struct{}),
+		wake:       make(chan struct{}, 1),
+		locations:  make(map[uuid.UUID]models.Location),
+		observedAt: make(map[uuid.UUID]time.Time),
+	}
+
+	svc.mu.Lock()
+	if svc.closed {
+		svc.mu.Unlock()
+		return nil, ErrStreamClosed
+	}
+	svc.streams[stream] = struct{}{}
+	svc.open.Add(1)
+	svc.mu.Unlock()
+
+	go stream.run(ctx)
+
+	return stream, nil
+}
+
+func (svc *StreamService) dispatch(event models.Event) {
+	svc.mu.RLock()
+	defer svc.mu.RUnlock()
+
+	for stream := range svc.streams {
+		stream.handle(event)
+	}
+}
+
+// Stream is a subscription of the client to the updates of the locations of the user.
+//
+// Updates are buffered, if the client doesn't keep up and the buffer is full,
+// the stream is closed with ErrSlowConsumer, so the client can reconnect and get the latest data.
+type Stream struct {
+	svc  *StreamService
+	user uuid.UUID
+
+	updates chan StreamUpdate
+	done    chan struct{}
+	// wake triggers the refresh out of schedule.
+	wake chan struct{}
+	// release makes Close idempotent.
+	release sync.Once
+
+	mu        sync.Mutex
+	err       error
+	saved     bool
+	locations map[uuid.UUID]models.Location
+	// observedAt is the time of the last pushed weather of the location,
+	// so the same data received from several sources is pushed once.
+	observedAt map[uuid.UUID]time.Time
+}
+
+// Updates returns the channel of the updates, it is never closed.
+func (s *Stream) Updates() <-chan StreamUpdate {
+	return s.updates
+}
+
+// Done is closed when the stream is closed, Err returns the reason then.
+func (s *Stream) Done() <-chan struct{} {
+	return s.done
+}
+
+// Err returns the reason why the stream was closed.
+func (s *Stream) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// Subscribe adds the saved location of the user to the stream and pushes its current weather.
+func (s *Stream) Subscribe(ctx context.Context, id uuid.UUID) (models.Location, error) {
+	location, err := s.svc.locationSvc.Get(ctx, s.user, id)
+	if err != nil {
+		return models.Location{}, err
+	}
+
+	s.mu.Lock()
+	s.locations[location.Id] = location
+	s.mu.Unlock()
+
+	s.refresh(ctx, location)
+
+	return location, nil
+}
+
+// SubscribeAll subscribes the stream to every saved location of the user, including the ones saved later.
+// The weather is pushed in the background.
+func (s *Stream) SubscribeAll(ctx context.Context) error {
+	if err := s.loadSaved(ctx); err != nil {
+		return err
+	}
+
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// loadSaved replaces the locations of the stream with the saved locations of the user.
+func (s *Stream) loadSaved(ctx context.Context) error {
+	locations, err := s.svc.locationSvc.List(ctx, s.user)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.saved = true
+	previous := s.locations
+	s.locations = make(map[uuid.UUID]models.Location, len(locations))
+	for _, location := range locations {
+		s.locations[location.Id] = location
+		if old, ok := previous[location.Id]; ok && old.Coordinates == location.Coordinates {
+			delete(previous, location.Id)
+		}
+	}
+	// The weather of the moved locations is pushed again, even if it was observed earlier.
+	for id := range previous {
+		delete(s.observedAt, id)
+	}
+
+	return nil
+}
+
+// reloadSubscribed reloads the locations subscribed one by one, so the stream follows the changes made by the user.
+// The deleted locations are dropped, the others are kept as is, if they can't be loaded.
+func (s *Stream) reloadSubscribed(ctx context.Context) {
+	for _, subscribed := range s.Locations() {
+		location, err := s.svc.locationSvc.Get(ctx, s.user, subscribed.Id)
+		if err != nil && !errors.Is(err, ErrLocationNotFound) {
+			continue
+		}
+
+		s.mu.Lock()
+		// The location could have been unsubscribed during the request.
+		if _, ok := s.locations[subscribed.Id]; ok {
+			if err != nil {
+				delete(s.locations, subscribed.Id)
+				delete(s.observedAt, subscribed.Id)
+			} else {
+				s.locations[location.Id] = location
+				if location.Coordinates != subscribed.Coordinates {
+					delete(s.observedAt, location.Id)
+				}
+			}
+		}
+		s.mu.Unlock()
+	}
+}
+
+// Unsubscribe removes the location from the stream, it returns false if the stream isn't subscribed to it.
+func (s *Stream) Unsubscribe(id uuid.UUID) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.locations[id]; !ok {
+		return false
+	}
+	delete(s.locations, id)
+	delete(s.observedAt, id)
+	return true
+}
+
+// Locations returns the locations the stream is subscribed to, in the order of the user.
+func (s *Stream) Locations() []models.Location {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	locations := make([]models.Location, 0, len(s.locations))
+	for _, location := range s.locations {
+		locations = append(locations, location)
+	}
+	slices.SortFunc(locations, func(a, b models.Location) int { return a.Position - b.Position })
+	return locations
+}
+
+// run refreshes the weather of the locations until the context is canceled or the stream is closed.
+// The requests go through the cache, so the stale entries are refreshed and published to the other streams too.
+func (s *Stream) run(ctx context.Context) {
+	ticker := time.NewTicker(s.svc.refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.done:
+			return
+		case <-s.wake:
+		case <-ticker.C:
+			s.mu.Lock()
+			saved := s.saved
+			s.mu.Unlock()
+
+			// The locations are reloaded, so the stream follows the changes made by the user.
+			if saved {
+				if err := s.loadSaved(ctx); err != nil {
+					continue
+				}
+			} else {
+				s.reloadSubscribed(ctx)
+			}
+		}
+
+		for _, location := range s.Locations() {
+			if ctx.Err() != nil {
+				return
+			}
+			s.refresh(ctx, location)
+		}
+	}
+}
+
+// Close removes the stream from the service and releases it.
+func (s *Stream) Close() {
+	s.release.Do(func() {
+		s.svc.mu.Lock()
+		delete(s.svc.streams, s)
+		s.svc.mu.Unlock()
+
+		s.close(ErrStreamClosed)
+		s.svc.open.Done()
+	})
+}
+
+func (s *Stream) refresh(ctx context.Context, location models.Location) {
+	weather, err := s.svc.weatherSvc.Current(ctx, location.Coordinates)
+	if err != nil {
+		// The provider failures are logged by the weather service, the data is pushed with the next refresh.
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// The location could have been unsubscribed during the request.
+	if _, ok := s.locations[location.Id]; ok {
+		s.pushWeather(location, weather)
+	}
+}
+
+func (s *Stream) handle(event models.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch event.Kind {
+	case models.EventWeather:
+		if event.Weather == nil {
+			return
+		}
+		coordinates := event.Coordinates.Round(s.svc.precision)
+		for _, location := range s.locations {
+			if location.Coordinates.Round(s.svc.precision) == coordinates {
+				s.pushWeather(location, normalizeCurrentWeather(*event.Weather))
+			}
+		}
+	case models.EventAlert:
+		if event.Alert == nil || event.Alert.User != s.user {
+			return
+		}
+		if location, ok := s.locations[event.Alert.Location]; ok {
+			s.push(StreamUpdate{Kind: models.EventAlert, Location: location, Alert: *event.Alert})
+		}
+	}
+}
+
+// pushWeather pushes the weather, unless it's older than the last pushed one. s.mu should be held.
+func (s *Stream) pushWeather(location models.Location, weather models.CurrentWeather) {
+	if observedAt, ok := s.observedAt[location.Id]; ok && !weather.ObservedAt.After(observedAt) {
+		return
+	}
+	s.observedAt[location.Id] = weather.ObservedAt
+
+	s.push(StreamUpdate{Kind: models.EventWeather, Location: location, Weather: weather})
+}
+
+// push sends the update without blocking, the stream is closed if its buffer is full. s.mu should be held.
+func (s *Stream) push(update StreamUpdate) {
+	if s.err != nil {
+		return
+	}
+
+	select {
+	case s.updates <- update:
+	default:
+		s.svc.logger.Warn("Closing the stream of slow consumer", "user", s.user)
+		s.closeLocked(ErrSlowConsumer)
+	}
+}
+
+func (s *Stream) close(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closeLocked(err)
+}
+
+func (s *Stream) closeLocked(err error) {
+	if s.err != nil {
+		return
+	}
+	s.err = err
+	close(s.done)
+}
+
+// NewStreamService creates the service, every stream buffers at most bufferSize updates
+// and requests the weather of its locations every refreshInterval.
+func NewStreamService(
+	logger *slog.Logger,
+	events repositories.EventRepository,
+	locationSvc *LocationService,
+	weatherSvc *WeatherService,
+	precision int,
+	bufferSize int,
+	refreshInterval time.Duration,
+) *StreamService {
+	return &StreamService{
+		logger:          logger,
+		events:          events,
+		locationSvc:     locationSvc,
+		weatherSvc:      weatherSvc,
+		precision:       precision,
+		bufferSize:      bufferSize,
+		refreshInterval: refreshInterval,
+		streams:         make(map[*Stream]struct{}),
+	}
+}
@@ -0,0 +1,160 @@
+package services
+
+import (
+	"errors"
+	"math"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+)
+
+// unit converts values from the units of the providers into the unit with the symbol,
+// nil convert means that the units are the same.
+type unit struct {
+	symbol  string
+	convert func(float64) float64
+}
+
+func scaled(symbol string, factor float64) unit {
+	return unit{symbol: symbol, convert: func(v float64) float64 { return v * factor }}
+}
+
+func identity(symbol string) unit {
+	return unit{symbol: symbol}
+}
+
+type unitSystem struct {
+	temperature   unit
+	pressure      unit
+	precipitation unit
+}
+
+var unitSystems = map[models.UnitSystem]unitSystem{
+	models.UnitsMetric: {
+		temperature:   identity("°C"),
+		pressure:      identity("hPa"),
+		precipitation: identity("mm"),
+	},
+	models.UnitsImperial: {
+		temperature:   unit{symbol: "°F", convert: func(c float64) float64 { return c*9/5 + 32 }},
+		pressure:      scaled("inHg", 1/33.8638866667),
+		precipitation: scaled("in", 1/25.4),
+	},
+	models.UnitsSI: {
+		temperature:   unit{symbol: "K", convert: func(c float64) float64 { return c + 273.15 }},
+		pressure:      scaled("Pa", 100),
+		precipitation: identity("mm"),
+	},
+}
+
+var windSpeedUnits = map[models.WindSpeedUnit]unit{
+	models.WindSpeedMetersPerSecond:   identity("m/s"),
+	models.WindSpeedKilometersPerHour: scaled("km/h", 3.6),
+	models.WindSpeedMilesPerHour:      scaled("mph", 3600/1609.344),
+	models.WindSpeedKnots:             scaled("kn", 3600/1852.0),
+}
+
+// UnitSymbols are the symbols of the units of the converted weather data.
+type UnitSymbols struct {
+	Temperature   string
+	WindSpeed     string
+	Pressure      string
+	Precipitation string
+}
+
+// UnitConverter converts the weather data from the units of the providers,
+// which are described in models.CurrentWeather, into the units selected by the user.
+type UnitConverter struct {
+	system    unitSystem
+	windSpeed unit
+}
+
+// CurrentWeather returns the weather with the converted values.
+func (c UnitConverter) CurrentWeather(weather models.CurrentWeather) models.CurrentWeather {
+	weather.Temperature = c.convert(c.system.temperature, weather.Temperature)
+	weather.FeelsLike = c.convert(c.system.temperature, weather.FeelsLike)
+	weather.Pressure = c.convert(c.system.pressure, weather.Pressure)
+	weather.WindSpeed = c.convert(c.windSpeed, weather.WindSpeed)
+	weather.WindGust = c.convert(c.windSpeed, weather.WindGust)
+	return weather
+}
+
+// HourlyForecast converts the values of the forecast in place.
+func (c UnitConverter) HourlyForecast(forecast []models.HourlyForecast) []models.HourlyForecast {
+	for i, f := range forecast {
+		f.Temperature = c.convert(c.system.temperature, f.Temperature)
+		f.FeelsLike = c.convert(c.system.temperature, f.FeelsLike)
+		f.Pressure = c.convert(c.system.pressure, f.Pressure)
+		f.WindSpeed = c.convert(c.windSpeed, f.WindSpeed)
+		f.WindGust = c.convert(c.windSpeed, f.WindGust)
+		f.Precipitation = c.convert(c.system.precipitation, f.Precipitation)
+		forecast[i] = f
+	}
+	return forecast
+}
+
+// DailyForecast converts the values of the forecast in place.
+func (c UnitConverter) DailyForecast(forecast []models.DailyForecast) []models.DailyForecast {
+	for i, f := range forecast {
+		f.TemperatureMin = c.convert(c.system.temperature, f.TemperatureMin)
+		f.TemperatureMax = c.convert(c.system.temperature, f.TemperatureMax)
+		f.Pressure = c.convert(c.system.pressure, f.Pressure)
+		f.WindSpeed = c.convert(c.windSpeed, f.WindSpeed)
+		f.WindGust = c.convert(c.windSpeed, f.WindGust)
+		f.Precipitation = c.convert(c.system.precipitation, f.Precipitation)
+		forecast[i] = f
+	}
+	return forecast
+}
+
+// Symbols returns the symbols of the units of the converted values.
+func (c UnitConverter) Symbols() UnitSymbols {
+	return UnitSymbols{
+		Temperature:   c.system.temperature.symbol,
+		WindSpeed:     c.windSpeed.symbol,
+		Pressure:      c.system.pressure.symbol,
+		Precipitation: c.system.precipitation.symbol,
+	}
+}
+
+// convert rounds the converted values to hundredths, so they don't have conversion artifacts.
+func (c UnitConverter) convert(u unit, v float64) float64 {
+	if u.convert == nil {
+		return v
+	}
+	return math.Round(u.convert(v)*100) / 100
+}
+
+// NewUnitConverter creates the converter into the units, unknown units are replaced with the default ones.
+func NewUnitConverter(units models.Units) UnitConverter {
+	system, ok := unitSystems[units.System]
+	if !ok {
+		system = unitSystems[models.UnitsMetric]
+	}
+	windSpeed, ok := windSpeedUnits[units.WindSpeed]
+	if !ok {
+		windSpeed = windSpeedUnits[models.WindSpeedMetersPerSecond]
+	}
+	return UnitConverter{system: system, windSpeed: windSpeed}
+}
+
+// OverrideUnits replaces the units from the preferences with the ones provided in the request.
+func OverrideUnits(units models.Units, system *models.UnitSystem, windSpeed *models.WindSpeedUnit) (models.Units, error) {
+	if system != nil {
+		units.System = *system
+	}
+	if windSpeed != nil {
+		units.WindSpeed = *windSpeed
+	}
+	return units, validateUnits(units)
+}
+
+func validateUnits(units models.Units) error {
+	var errs []error
+	if _, ok := unitSystems[units.System]; !ok {
+		errs = append(errs, &ValidationError{Field: "units", Message: "should be one of metric, imperial or si"})
+	}
+	if _, ok := windSpeedUnits[units.WindSpeed]; !ok {
+		errs = append(errs, &ValidationError{Field: "windSpeedUnit", Message: "should be one of mps, kmh, mph or knots"})
+	}
+	return errors.Join(errs...)
+}
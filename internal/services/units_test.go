@@ -0,0 +1,133 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+)
+
+func TestUnitConverterCurrentWeather(t *testing.T) {
+	weather := models.CurrentWeather{
+		Temperature: 20,
+		FeelsLike:   -40,
+		Pressure:    1013.25,
+		WindSpeed:   10,
+		WindGust:    0,
+		Humidity:    55,
+	}
+
+	tests := []struct {
+		name  string
+		units models.Units
+		want  models.CurrentWeather
+		// symbols are temperature, wind speed, pressure and precipitation.
+		symbols UnitSymbols
+	}{
+		{
+			name:    "metric",
+			units:   models.Units{System: models.UnitsMetric, WindSpeed: models.WindSpeedMetersPerSecond},
+			want:    weather,
+			symbols: UnitSymbols{"°C", "m/s", "hPa", "mm"},
+		},
+		{
+			name:  "imperial",
+			units: models.Units{System: models.UnitsImperial, WindSpeed: models.WindSpeedMilesPerHour},
+			want: models.CurrentWeather{
+				Temperature: 68, FeelsLike: -40, Pressure: 29.92, WindSpeed: 22.37, WindGust: 0, Humidity: 55,
+			},
+			symbols: UnitSymbols{"°F", "mph", "inHg", "in"},
+		},
+		{
+			name:  "si",
+			units: models.Units{System: models.UnitsSI, WindSpeed: models.WindSpeedKilometersPerHour},
+			want: models.CurrentWeather{
+				Temperature: 293.15, FeelsLike: 233.15, Pressure: 101325, WindSpeed: 36, WindGust: 0, Humidity: 55,
+			},
+			symbols: UnitSymbols{"K", "km/h", "Pa", "mm"},
+		},
+		{
+			name:  "knots",
+			units: models.Units{System: models.UnitsMetric, WindSpeed: models.WindSpeedKnots},
+			want: models.CurrentWeather{
+				Temperature: 20, FeelsLike: -40, Pressure: 1013.25, WindSpeed: 19.44, WindGust: 0, Humidity: 55,
+			},
+			symbols: UnitSymbols{"°C", "kn", "hPa", "mm"},
+		},
+		{
+			name:    "unknown units fall back to metric",
+			units:   models.Units{System: "furlongs", WindSpeed: "beaufort"},
+			want:    weather,
+			symbols: UnitSymbols{"°C", "m/s", "hPa", "mm"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			converter := NewUnitConverter(tt.units)
+
+			if got := converter.CurrentWeather(weather); got != tt.want {
+				t.Errorf("CurrentWeather() = %+v, want %+v", got, tt.want)
+			}
+			if got := converter.Symbols(); got != tt.symbols {
+				t.Errorf("Symbols() = %+v, want %+v", got, tt.symbols)
+			}
+		})
+	}
+}
+
+func TestUnitConverterForecasts(t *testing.T) {
+	converter := NewUnitConverter(models.Units{System: models.UnitsImperial, WindSpeed: models.WindSpeedKilometersPerHour})
+
+	hourly := converter.HourlyForecast([]models.HourlyForecast{
+		{Temperature: 0, FeelsLike: 100, Pressure: 1000, WindSpeed: 5, WindGust: 12.5, Precipitation: 25.4, Humidity: 80},
+	})
+	wantHourly := models.HourlyForecast{
+		Temperature: 32, FeelsLike: 212, Pressure: 29.53, WindSpeed: 18, WindGust: 45, Precipitation: 1, Humidity: 80,
+	}
+	if hourly[0] != wantHourly {
+		t.Errorf("HourlyForecast() = %+v, want %+v", hourly[0], wantHourly)
+	}
+
+	daily := converter.DailyForecast([]models.DailyForecast{
+		{TemperatureMin: -10, TemperatureMax: 30, Pressure: 1013.25, WindSpeed: 1, Precipitation: 12.7},
+	})
+	wantDaily := models.DailyForecast{
+		TemperatureMin: 14, TemperatureMax: 86, Pressure: 29.92, WindSpeed: 3.6, Precipitation: 0.5,
+	}
+	if daily[0] != wantDaily {
+		t.Errorf("DailyForecast() = %+v, want %+v", daily[0], wantDaily)
+	}
+}
+
+func TestOverrideUnits(t *testing.T) {
+	preferences := models.Units{System: models.UnitsMetric, WindSpeed: models.WindSpeedMetersPerSecond}
+	system := models.UnitsImperial
+	windSpeed := models.WindSpeedKnots
+
+	units, err := OverrideUnits(preferences, &system, nil)
+	if err != nil {
+		t.Fatalf("OverrideUnits() error = %v", err)
+	}
+	if want := (models.Units{System: models.UnitsImperial, WindSpeed: models.WindSpeedMetersPerSecond}); units != want {
+		t.Errorf("OverrideUnits(system) = %+v, want %+v", units, want)
+	}
+
+	units, err = OverrideUnits(preferences, nil, &windSpeed)
+	if err != nil {
+		t.Fatalf("OverrideUnits() error = %v", err)
+	}
+	if want := (models.Units{System: models.UnitsMetric, WindSpeed: models.WindSpeedKnots}); units != want {
+		t.Errorf("OverrideUnits(windSpeed) = %+v, want %+v", units, want)
+	}
+
+	invalidSystem := models.UnitSystem("furlongs")
+	invalidWindSpeed := models.WindSpeedUnit("beaufort")
+	_, err = OverrideUnits(preferences, &invalidSystem, &invalidWindSpeed)
+	var fields []string
+	for _, validationErr := range ValidationErrors(err) {
+		fields = append(fields, validationErr.Field)
+	}
+	if len(fields) != 2 || fields[0] != "units" || fields[1] != "windSpeedUnit" {
+		t.Errorf("OverrideUnits(invalid) fields = %v, want [units windSpeedUnit]", fields)
+	}
+}
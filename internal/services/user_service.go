@@ -2,14 +2,19 @@ package services
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
 	"errors"
 	"log/slog"
-	"math/rand"
+	"slices"
+	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
-	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/text/language"
 
 	"github.com/maxdikun/weatherapp/internal/models"
 	"github.com/maxdikun/weatherapp/internal/repositories"
@@ -22,6 +27,28 @@ type TokenPair struct {
 	RefreshExpiresAt time.Time
 }
 
+// ClientInfo describes the device, which is making the request.
+type ClientInfo struct {
+	UserAgent string
+	IP        string
+}
+
+// PreferencesUpdate contains changed preferences, nil fields are left as is.
+type PreferencesUpdate struct {
+	Units         *models.UnitSystem
+	WindSpeedUnit *models.WindSpeedUnit
+	TimeZone      *string
+	Language      *string
+	Clock         *models.ClockFormat
+}
+
+// accessTokenClaims are the claims of the access tokens.
+type accessTokenClaims struct {
+	jwt.RegisteredClaims
+	// SessionId is the id of the session, which issued the token.
+	SessionId string `json:"sid,omitempty"`
+}
+
 type UserService struct {
 	logger *slog.Logger
 
@@ -30,11 +57,22 @@ type UserService struct {
 
 	sessionDuration     time.Duration
 	accessTokenDuration time.Duration
-	tokenSecret         []byte
+	keyring             *Keyring
+	tokenIssuer         string
+	tokenAudience       string
+
+	passwordPolicy *PasswordPolicy
+	passwordHasher PasswordHasher
+	// dummyPasswordHash is verified when the login doesn't exist,
+	// so the response time doesn't tell whether an account exists.
+	dummyPasswordHash string
+	loginGuard        *LoginGuard
+
+	notificationSvc *NotificationService
 }
 
-func (svc *UserService) Register(ctx context.Context, login string, password string) (TokenPair, error) {
-	err := errors.Join(svc.validateLogin(login), svc.validatePassword(password))
+func (svc *UserService) Register(ctx context.Context, login string, password string, client ClientInfo) (TokenPair, error) {
+	err := errors.Join(svc.validateLogin(login), svc.passwordPolicy.Validate(login, password))
 	if err != nil {
 		return TokenPair{}, err
 	}
@@ -44,45 +82,428 @@ func (svc *UserService) Register(ctx context.Context, login string, password str
 		return TokenPair{}, err
 	}
 
-	session, err := svc.createSession(ctx, user)
+	session, err := svc.createSession(ctx, user, client)
 	if err != nil {
 		return TokenPair{}, err
 	}
 
-	expiresAt := time.Now().Add(svc.accessTokenDuration)
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"exp":  expiresAt,
-		"user": session.User,
-	})
-	tokenString, err := token.SignedString(svc.tokenSecret)
+	return svc.newTokenPair(session)
+}
+
+func (svc *UserService) Login(ctx context.Context, login string, password string, client ClientInfo) (TokenPair, error) {
+	if err := svc.guardAttempt(ctx, login, client.IP); err != nil {
+		return TokenPair{}, err
+	}
+
+	user, err := svc.verifyCredentials(ctx, login, password)
 	if err != nil {
+		svc.finishAttempt(ctx, login, client.IP, err)
+		return TokenPair{}, err
+	}
+	svc.finishAttempt(ctx, login, client.IP, nil)
+
+	session, err := svc.createSession(ctx, user, client)
+	if err != nil {
+		return TokenPair{}, err
+	}
+
+	// The session is unusable until the tokens are returned, so it's revoked, if the user can't be notified about it.
+	if err := svc.notificationSvc.Enqueue(ctx, newLoginNotification(user.Id, session)); err != nil {
+		if err := svc.sessionStorage.DeleteById(ctx, session.Id); err != nil {
+			svc.logger.Error("Failed to revoke the session", "session", session.Id, "err", err)
+		}
+		return TokenPair{}, err
+	}
+
+	return svc.newTokenPair(session)
+}
+
+// ChangePassword replaces the password of the user after verifying the current one, and revokes
+// every session of the user except the current one. Wrong passwords are counted like failed logins,
+// so a stolen access token can't be used to guess the password.
+func (svc *UserService) ChangePassword(
+	ctx context.Context,
+	user uuid.UUID,
+	session uuid.UUID,
+	currentPassword string,
+	newPassword string,
+	client ClientInfo,
+) error {
+	found, err := svc.userStorage.FindById(ctx, user)
+	if err != nil {
+		svc.logger.Error("Failed to find user", "user", user, "err", err)
+		return ErrInternal
+	}
+
+	if err := svc.guardAttempt(ctx, found.Login, client.IP); err != nil {
+		return err
+	}
+
+	ok, err := svc.passwordHasher.Verify(currentPassword, found.Password)
+	if err != nil {
+		svc.finishAttempt(ctx, found.Login, client.IP, err)
+		svc.logger.Error("Failed to verify password hash", "user", user, "err", err)
+		return ErrInternal
+	}
+	// The user is authenticated, so a wrong password is invalid input rather than failed authentication.
+	if !ok {
+		svc.finishAttempt(ctx, found.Login, client.IP, ErrInvalidCredentials)
+		return &ValidationError{Field: "currentPassword", Message: "is wrong"}
+	}
+	svc.finishAttempt(ctx, found.Login, client.IP, nil)
+
+	if err := svc.passwordPolicy.Validate(found.Login, newPassword); err != nil {
+		for _, validationErr := range ValidationErrors(err) {
+			validationErr.Field = "newPassword"
+		}
+		return err
+	}
+
+	hashedPassword, err := svc.passwordHasher.Hash(newPassword)
+	if err != nil {
+		svc.logger.Error("Failed to hash password", "user", user, "err", err)
+		return ErrInternal
+	}
+
+	err = svc.userStorage.ChangePassword(ctx, user, hashedPassword, passwordChangedNotification(user, client, time.Now()))
+	if err != nil {
+		svc.logger.Error("Failed to update password", "user", user, "err", err)
+		return ErrInternal
+	}
+
+	if err := svc.revokeOtherSessions(ctx, user, session); err != nil {
+		svc.logger.Error("Failed to revoke sessions after password change", "user", user, "err", err)
+		return ErrInternal
+	}
+
+	return nil
+}
+
+func (svc *UserService) Refresh(ctx context.Context, refreshToken string, client ClientInfo) (TokenPair, error) {
+	session, err := svc.sessionStorage.FindByToken(ctx, refreshToken)
+	if err != nil {
+		var notFound *repositories.NotFoundError
+		if errors.As(err, &notFound) {
+			svc.revokeReusedSession(ctx, refreshToken)
+			return TokenPair{}, ErrInvalidToken
+		}
 		return TokenPair{}, ErrInternal
 	}
 
-	return TokenPair{
-		Access:           tokenString,
-		AccessExpiresAt:  expiresAt,
-		Refresh:          session.Token,
-		RefreshExpiresAt: session.ExpiresAt,
-	}, nil
+	if time.Now().After(session.ExpiresAt) {
+		return TokenPair{}, ErrInvalidToken
+	}
+
+	session.Token = newRefreshToken()
+	session.RefreshedAt = time.Now()
+	session.UserAgent = client.UserAgent
+	session.IP = client.IP
+
+	if err := svc.sessionStorage.Rotate(ctx, refreshToken, session); err != nil {
+		var notFound *repositories.NotFoundError
+		if errors.As(err, &notFound) {
+			// The token was rotated by a concurrent request, so it has been used twice.
+			svc.revokeReusedSession(ctx, refreshToken)
+			return TokenPair{}, ErrInvalidToken
+		}
+		return TokenPair{}, ErrInternal
+	}
+
+	return svc.newTokenPair(session)
+}
+
+func (svc *UserService) Logout(ctx context.Context, refreshToken string) error {
+	if err := svc.sessionStorage.Delete(ctx, refreshToken); err != nil {
+		var notFound *repositories.NotFoundError
+		if errors.As(err, &notFound) {
+			return ErrInvalidToken
+		}
+		return ErrInternal
+	}
+	return nil
+}
+
+func (svc *UserService) LogoutAll(ctx context.Context, refreshToken string) error {
+	session, err := svc.sessionStorage.FindByToken(ctx, refreshToken)
+	if err != nil {
+		var notFound *repositories.NotFoundError
+		if errors.As(err, &notFound) {
+			return ErrInvalidToken
+		}
+		return ErrInternal
+	}
+
+	if err := svc.sessionStorage.DeleteByUser(ctx, session.User); err != nil {
+		return ErrInternal
+	}
+	return nil
+}
+
+// Authenticate validates the access token and returns the id of its user.
+func (svc *UserService) Authenticate(accessToken string) (uuid.UUID, error) {
+	user, _, err := svc.AuthenticateUntil(accessToken)
+	return user, err
+}
+
+// AuthenticateUntil is Authenticate, which also returns the expiration time of the token.
+// Long-lived connections use it to require a fresh token, when the old one expires.
+func (svc *UserService) AuthenticateUntil(accessToken string) (uuid.UUID, time.Time, error) {
+	user, claims, err := svc.parseAccessToken(accessToken)
+	if err != nil {
+		return uuid.Nil, time.Time{}, err
+	}
+
+	// The expiration is required by the parser, so it's always present.
+	return user, claims.ExpiresAt.Time, nil
+}
+
+// AuthenticateSession is Authenticate, which also returns the id of the session, which issued the token.
+// The id is uuid.Nil for the tokens issued before it was included into them.
+func (svc *UserService) AuthenticateSession(accessToken string) (uuid.UUID, uuid.UUID, error) {
+	user, claims, err := svc.parseAccessToken(accessToken)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	session, _ := uuid.Parse(claims.SessionId)
+	return user, session, nil
+}
+
+func (svc *UserService) parseAccessToken(accessToken string) (uuid.UUID, accessTokenClaims, error) {
+	var claims accessTokenClaims
+	_, err := svc.keyring.Parse(
+		accessToken,
+		&claims,
+		jwt.WithIssuer(svc.tokenIssuer),
+		jwt.WithAudience(svc.tokenAudience),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+	)
+	if err != nil {
+		return uuid.Nil, accessTokenClaims{}, ErrInvalidToken
+	}
+
+	user, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return uuid.Nil, accessTokenClaims{}, ErrInvalidToken
+	}
+
+	return user, claims, nil
+}
+
+// PublicKeys returns the keys, which can be used by other services to validate access tokens.
+func (svc *UserService) PublicKeys() []JSONWebKey {
+	return svc.keyring.PublicKeys()
+}
+
+// ListSessions returns active sessions of the user, the most recently used come first.
+func (svc *UserService) ListSessions(ctx context.Context, user uuid.UUID) ([]models.Session, error) {
+	sessions, err := svc.sessionStorage.FindByUser(ctx, user)
+	if err != nil {
+		return nil, ErrInternal
+	}
+
+	slices.SortFunc(sessions, func(a, b models.Session) int {
+		return b.RefreshedAt.Compare(a.RefreshedAt)
+	})
+
+	return sessions, nil
+}
+
+// DeleteSession revokes a session of the user.
+func (svc *UserService) DeleteSession(ctx context.Context, user uuid.UUID, sessionId uuid.UUID) error {
+	session, err := svc.sessionStorage.FindById(ctx, sessionId)
+	if err != nil {
+		var notFound *repositories.NotFoundError
+		if errors.As(err, &notFound) {
+			return ErrSessionNotFound
+		}
+		return ErrInternal
+	}
+
+	// Sessions of other users should be indistinguishable from missing ones.
+	if session.User != user {
+		return ErrSessionNotFound
+	}
+
+	if err := svc.sessionStorage.DeleteById(ctx, sessionId); err != nil {
+		var notFound *repositories.NotFoundError
+		if errors.As(err, &notFound) {
+			return ErrSessionNotFound
+		}
+		return ErrInternal
+	}
+
+	return nil
+}
+
+// Preferences returns the preferences of the user, or the default ones if they were never changed.
+func (svc *UserService) Preferences(ctx context.Context, user uuid.UUID) (models.Preferences, error) {
+	preferences, err := svc.userStorage.FindPreferences(ctx, user)
+	if err != nil {
+		var notFound *repositories.NotFoundError
+		if errors.As(err, &notFound) {
+			return models.DefaultPreferences(user), nil
+		}
+		svc.logger.Error("Failed to find preferences", "user", user, "err", err)
+		return models.Preferences{}, ErrInternal
+	}
+
+	return preferences, nil
+}
+
+// UpdatePreferences changes the preferences of the user.
+func (svc *UserService) UpdatePreferences(ctx context.Context, user uuid.UUID, update PreferencesUpdate) (models.Preferences, error) {
+	preferences, err := svc.Preferences(ctx, user)
+	if err != nil {
+		return models.Preferences{}, err
+	}
+
+	if update.Units != nil {
+		preferences.Units.System = *update.Units
+	}
+	if update.WindSpeedUnit != nil {
+		preferences.Units.WindSpeed = *update.WindSpeedUnit
+	}
+	if update.TimeZone != nil {
+		preferences.TimeZone = strings.TrimSpace(*update.TimeZone)
+	}
+	if update.Clock != nil {
+		preferences.Clock = *update.Clock
+	}
+
+	var languageErr error
+	if update.Language != nil {
+		preferences.Language, languageErr = canonicalLanguage(*update.Language)
+	}
+
+	err = errors.Join(
+		validateUnits(preferences.Units),
+		validateTimeZone(preferences.TimeZone),
+		languageErr,
+		validateClock(preferences.Clock),
+	)
+	if err != nil {
+		return models.Preferences{}, err
+	}
+
+	if err := svc.userStorage.SavePreferences(ctx, preferences); err != nil {
+		svc.logger.Error("Failed to save preferences", "user", user, "err", err)
+		return models.Preferences{}, ErrInternal
+	}
+
+	return preferences, nil
+}
+
+// guardAttempt counts the attempt to authenticate with the password of the login,
+// it should be finished with finishAttempt.
+func (svc *UserService) guardAttempt(ctx context.Context, login string, ip string) error {
+	if err := svc.loginGuard.Attempt(ctx, login, ip); err != nil {
+		var locked *LockedError
+		if errors.As(err, &locked) {
+			return err
+		}
+		svc.logger.Error("Failed to check login lockout", "err", err)
+		return ErrInternal
+	}
+	return nil
+}
+
+// finishAttempt records the outcome of the attempt, the failed one stays counted.
+func (svc *UserService) finishAttempt(ctx context.Context, login string, ip string, err error) {
+	switch {
+	case err == nil:
+		if err := svc.loginGuard.Succeed(ctx, login, ip); err != nil {
+			svc.logger.Error("Failed to reset failed login attempts", "err", err)
+		}
+	case !errors.Is(err, ErrInvalidCredentials):
+		if err := svc.loginGuard.Abort(ctx, login, ip); err != nil {
+			svc.logger.Error("Failed to undo login attempt", "err", err)
+		}
+	}
+}
+
+// revokeOtherSessions deletes the sessions of the user, except the current one.
+func (svc *UserService) revokeOtherSessions(ctx context.Context, user uuid.UUID, current uuid.UUID) error {
+	sessions, err := svc.sessionStorage.FindByUser(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, session := range sessions {
+		if session.Id == current {
+			continue
+		}
+		if err := svc.sessionStorage.DeleteById(ctx, session.Id); err != nil {
+			var notFound *repositories.NotFoundError
+			if !errors.As(err, &notFound) {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// revokeReusedSession deletes the session if the token was already rotated,
+// because a replayed refresh token means that it could have been stolen.
+func (svc *UserService) revokeReusedSession(ctx context.Context, refreshToken string) {
+	session, err := svc.sessionStorage.FindByRotatedToken(ctx, refreshToken)
+	if err != nil {
+		return
+	}
+
+	svc.logger.Warn("Reuse of rotated refresh token detected, revoking the session",
+		"session", session.Id, "user", session.User)
+
+	if err := svc.sessionStorage.DeleteById(ctx, session.Id); err != nil {
+		svc.logger.Error("Failed to revoke the session", "session", session.Id, "err", err)
+	}
 }
 
 func (svc *UserService) validateLogin(login string) error {
-	if len(login) < 3 {
+	if utf8.RuneCountInString(login) < 3 {
 		return &ValidationError{Field: "login", Message: "should be at least 3 characters long"}
 	}
+	if len(login) > 255 {
+		return &ValidationError{Field: "login", Message: "should be at most 255 bytes long"}
+	}
+	if strings.ContainsFunc(login, func(r rune) bool { return unicode.IsSpace(r) || !unicode.IsPrint(r) }) {
+		return &ValidationError{Field: "login", Message: "should not contain whitespace or control characters"}
+	}
 	return nil
 }
 
-func (svc *UserService) validatePassword(password string) error {
-	if len(password) < 6 {
-		return &ValidationError{Field: "password", Message: "should be at least 6 characters long"}
+func validateTimeZone(name string) error {
+	// LoadLocation treats empty name as UTC and "Local" as the zone of the server.
+	if name == "" || name == "Local" || len(name) > 64 {
+		return &ValidationError{Field: "timeZone", Message: "should be a name of IANA time zone"}
+	}
+	if _, err := time.LoadLocation(name); err != nil {
+		return &ValidationError{Field: "timeZone", Message: "should be a name of IANA time zone"}
+	}
+	return nil
+}
+
+// canonicalLanguage validates the language tag and returns it in the canonical form, e.g. "en-US" for "en_us".
+func canonicalLanguage(tag string) (string, error) {
+	tag = strings.TrimSpace(tag)
+	parsed, err := language.Parse(tag)
+	if err != nil || len(tag) > 35 {
+		return "", &ValidationError{Field: "language", Message: "should be a BCP 47 language tag"}
+	}
+	return parsed.String(), nil
+}
+
+func validateClock(clock models.ClockFormat) error {
+	if clock != models.Clock24h && clock != models.Clock12h {
+		return &ValidationError{Field: "clock", Message: "should be one of 24h or 12h"}
 	}
 	return nil
 }
 
 func (svc *UserService) createUser(ctx context.Context, login string, password string) (models.User, error) {
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	hashedPassword, err := svc.passwordHasher.Hash(password)
 	if err != nil {
 		return models.User{}, ErrInternal
 	}
@@ -90,25 +511,71 @@ func (svc *UserService) createUser(ctx context.Context, login string, password s
 	user := models.User{
 		Id:       uuid.New(),
 		Login:    login,
-		Password: string(hashedPassword),
+		Password: hashedPassword,
 	}
 
 	if err := svc.userStorage.Add(ctx, user); err != nil {
-		// TODO: Handle the other errors
+		var alreadyExists *repositories.AlreadyExistsError
+		if errors.As(err, &alreadyExists) {
+			return models.User{}, &ConflictError{Object: alreadyExists.Object, Field: alreadyExists.Field}
+		}
 		return models.User{}, ErrInternal
 	}
 
 	return user, nil
 }
 
-func (svc *UserService) createSession(ctx context.Context, user models.User) (models.Session, error) {
+func (svc *UserService) verifyCredentials(ctx context.Context, login string, password string) (models.User, error) {
+	user, err := svc.userStorage.FindByLogin(ctx, login)
+	if err != nil {
+		var notFound *repositories.NotFoundError
+		if errors.As(err, &notFound) {
+			_, _ = svc.passwordHasher.Verify(password, svc.dummyPasswordHash)
+			return models.User{}, ErrInvalidCredentials
+		}
+		return models.User{}, ErrInternal
+	}
+
+	ok, err := svc.passwordHasher.Verify(password, user.Password)
+	if err != nil {
+		svc.logger.Error("Failed to verify password hash", "user", user.Id, "err", err)
+		return models.User{}, ErrInternal
+	}
+	if !ok {
+		return models.User{}, ErrInvalidCredentials
+	}
+
+	if svc.passwordHasher.NeedsRehash(user.Password) {
+		svc.rehashPassword(ctx, user, password)
+	}
+
+	return user, nil
+}
+
+// rehashPassword upgrades the stored hash of the password to the current algorithm and parameters.
+// Failure is not fatal, the old hash stays valid and the upgrade is retried on the next login.
+func (svc *UserService) rehashPassword(ctx context.Context, user models.User, password string) {
+	hashedPassword, err := svc.passwordHasher.Hash(password)
+	if err != nil {
+		svc.logger.Error("Failed to rehash password", "user", user.Id, "err", err)
+		return
+	}
+
+	if err := svc.userStorage.UpdatePassword(ctx, user.Id, hashedPassword); err != nil {
+		svc.logger.Error("Failed to store rehashed password", "user", user.Id, "err", err)
+	}
+}
+
+func (svc *UserService) createSession(ctx context.Context, user models.User, client ClientInfo) (models.Session, error) {
 	session := models.Session{
 		Id:          uuid.New(),
 		User:        user.Id,
-		Token:       randomString(32),
+		Token:       newRefreshToken(),
 		CreatedAt:   time.Now(),
 		RefreshedAt: time.Now(),
 		ExpiresAt:   time.Now().Add(svc.sessionDuration),
+		UserAgent:   client.UserAgent,
+		IP:          client.IP,
 	}
 
 	if err := svc.sessionStorage.Add(ctx, session); err != nil {
@@ -119,30 +586,72 @@ func (svc *UserService) createSession(ctx context.Context, user models.User) (mo
 	return session, nil
 }
 
+func (svc *UserService) newTokenPair(session models.Session) (TokenPair, error) {
+	now := time.Now()
+	expiresAt := now.Add(svc.accessTokenDuration)
+
+	tokenString, err := svc.keyring.Sign(accessTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Issuer:    svc.tokenIssuer,
+			Audience:  jwt.ClaimStrings{svc.tokenAudience},
+			Subject:   session.User.String(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+		SessionId: session.Id.String(),
+	})
+	if err != nil {
+		return TokenPair{}, ErrInternal
+	}
+
+	return TokenPair{
+		Access:           tokenString,
+		AccessExpiresAt:  expiresAt,
+		Refresh:          session.Token,
+		RefreshExpiresAt: session.ExpiresAt,
+	}, nil
+}
+
 func NewUserService(
 	logger *slog.Logger,
 	userStorage repositories.UserRepository,
 	sessionStorage repositories.SessionRepository,
 	sessionDuration time.Duration,
 	accessTokenDuration time.Duration,
-	tokenSecret []byte,
+	keyring *Keyring,
+	tokenIssuer string,
+	tokenAudience string,
+	passwordPolicy *PasswordPolicy,
+	passwordHasher PasswordHasher,
+	loginGuard *LoginGuard,
+	notificationSvc *NotificationService,
 ) *UserService {
+	// Hashing fails only with invalid parameters, which are validated on startup.
+	dummyPasswordHash, _ := passwordHasher.Hash(newRefreshToken())
+
 	return &UserService{
 		logger:              logger,
 		userStorage:         userStorage,
 		sessionStorage:      sessionStorage,
 		sessionDuration:     sessionDuration,
 		accessTokenDuration: accessTokenDuration,
-		tokenSecret:         tokenSecret,
+		keyring:             keyring,
+		tokenIssuer:         tokenIssuer,
+		tokenAudience:       tokenAudience,
+		passwordPolicy:      passwordPolicy,
+		passwordHasher:      passwordHasher,
+		dummyPasswordHash:   dummyPasswordHash,
+		loginGuard:          loginGuard,
+		notificationSvc:     notificationSvc,
 	}
 }
 
-func randomString(n int) string {
-	var letters = []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789")
-
-	s := make([]rune, n)
-	for i := range s {
-		s[i] = letters[rand.Intn(len(letters))]
-	}
-	return string(s)
+// newRefreshToken generates a random URL-safe token with 256 bits of entropy.
+func newRefreshToken() string {
+	b := make([]byte, 32)
+	// Read never returns an error, it crashes the program if the system randomness fails.
+	_, _ = rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
 }
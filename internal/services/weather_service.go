@@ -0,0 +1,202 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+	"github.com/maxdikun/weatherapp/internal/providers"
+)
+
+const (
+	MaxForecastHours = 48
+	MaxForecastDays  = 16
+)
+
+type WeatherService struct {
+	logger *slog.Logger
+
+	provider providers.WeatherProvider
+}
+
+func (svc *WeatherService) Current(ctx context.Context, coordinates models.Coordinates) (models.CurrentWeather, error) {
+	if err := validateCoordinates(coordinates); err != nil {
+		return models.CurrentWeather{}, err
+	}
+
+	weather, err := svc.provider.Current(ctx, coordinates)
+	if err != nil {
+		return models.CurrentWeather{}, svc.providerError(err)
+	}
+
+	return normalizeCurrentWeather(weather), nil
+}
+
+// HourlyForecast returns forecasts for the next hours, starting from the current one.
+func (svc *WeatherService) HourlyForecast(ctx context.Context, coordinates models.Coordinates, hours int) ([]models.HourlyForecast, error) {
+	err := errors.Join(validateCoordinates(coordinates), validatePeriods(hours, MaxForecastHours))
+	if err != nil {
+		return nil, err
+	}
+
+	// The whole forecast is requested, so it is cached once for any number of hours.
+	forecast, err := svc.provider.HourlyForecast(ctx, coordinates, MaxForecastHours)
+	if err != nil {
+		return nil, svc.providerError(err)
+	}
+
+	return normalizeHourlyForecast(forecast, time.Now(), hours), nil
+}
+
+// DailyForecast returns forecasts for the next days, starting from today.
+func (svc *WeatherService) DailyForecast(ctx context.Context, coordinates models.Coordinates, days int) ([]models.DailyForecast, error) {
+	err := errors.Join(validateCoordinates(coordinates), validatePeriods(days, MaxForecastDays))
+	if err != nil {
+		return nil, err
+	}
+
+	forecast, err := svc.provider.DailyForecast(ctx, coordinates, MaxForecastDays)
+	if err != nil {
+		return nil, svc.providerError(err)
+	}
+
+	return normalizeDailyForecast(forecast, time.Now(), days), nil
+}
+
+func (svc *WeatherService) providerError(err error) error {
+	if errors.Is(err, context.Canceled) {
+		return err
+	}
+
+	svc.logger.Error("Weather provider failed", "err", err)
+	return ErrWeatherUnavailable
+}
+
+func validatePeriods(periods int, max int) error {
+	if periods < 1 || periods > max {
+		return &ValidationError{Field: "periods", Message: fmt.Sprintf("should be between 1 and %d", max)}
+	}
+	return nil
+}
+
+// normalizeCurrentWeather brings the values of the current weather into their ranges.
+func normalizeCurrentWeather(weather models.CurrentWeather) models.CurrentWeather {
+	weather.Humidity = clamp(weather.Humidity, 0, 100)
+	weather.WindDirection = normalizeDirection(weather.WindDirection)
+	return weather
+}
+
+// normalizeHourlyForecast orders the forecast by time, drops past and duplicate hours,
+// limits it to the requested number of hours and brings the values into their ranges.
+func normalizeHourlyForecast(forecast []models.HourlyForecast, now time.Time, hours int) []models.HourlyForecast {
+	// The forecast can be shared by the concurrent requests through the cache, so a copy is sorted.
+	forecast = slices.Clone(forecast)
+	slices.SortStableFunc(forecast, func(a, b models.HourlyForecast) int {
+		return a.Time.Compare(b.Time)
+	})
+
+	currentHour := now.UTC().Truncate(time.Hour)
+
+	result := make([]models.HourlyForecast, 0, hours)
+	for _, f := range forecast {
+		if len(result) == hours {
+			break
+		}
+
+		f.Time = f.Time.UTC()
+		if f.Time.Before(currentHour) {
+			continue
+		}
+		if len(result) != 0 && result[len(result)-1].Time.Equal(f.Time) {
+			continue
+		}
+
+		f.Humidity = clamp(f.Humidity, 0, 100)
+		f.PrecipitationProbability = clamp(f.PrecipitationProbability, 0, 100)
+		f.Precipitation = max(f.Precipitation, 0)
+		f.WindDirection = normalizeDirection(f.WindDirection)
+		result = append(result, f)
+	}
+
+	return result
+}
+
+// normalizeDailyForecast orders the forecast by date, drops past and duplicate days,
+// limits it to the requested number of days and brings the values into their ranges.
+func normalizeDailyForecast(forecast []models.DailyForecast, now time.Time, days int) []models.DailyForecast {
+	// The forecast can be shared by the concurrent requests through the cache, so a copy is sorted.
+	forecast = slices.Clone(forecast)
+	slices.SortStableFunc(forecast, func(a, b models.DailyForecast) int {
+		return a.Date.Compare(b.Date)
+	})
+
+	today := now.UTC().Truncate(24 * time.Hour)
+
+	result := make([]models.DailyForecast, 0, days)
+	for _, f := range forecast {
+		if len(result) == days {
+			break
+		}
+
+		f.Date = f.Date.UTC().Truncate(24 * time.Hour)
+		if f.Date.Before(today) {
+			continue
+		}
+		if len(result) != 0 && result[len(result)-1].Date.Equal(f.Date) {
+			continue
+		}
+
+		if f.TemperatureMin > f.TemperatureMax {
+			f.TemperatureMin, f.TemperatureMax = f.TemperatureMax, f.TemperatureMin
+		}
+		f.Humidity = clamp(f.Humidity, 0, 100)
+		f.PrecipitationProbability = clamp(f.PrecipitationProbability, 0, 100)
+		f.Precipitation = max(f.Precipitation, 0)
+		f.WindDirection = normalizeDirection(f.WindDirection)
+		result = append(result, f)
+	}
+
+	return result
+}
+
+func clamp(v float64, low float64, high float64) float64 {
+	return min(max(v, low), high)
+}
+
+// normalizeDirection brings the direction in degrees into [0, 360).
+func normalizeDirection(deg float64) float64 {
+	deg = math.Mod(deg, 360)
+	if deg < 0 {
+		deg += 360
+	}
+	return deg
+}
+
+func validateCoordinates(coordinates models.Coordinates) error {
+	return validateCoordinateFields(coordinates, "lat", "lon")
+}
+
+// validateCoordinateFields reports invalid coordinates with the names of the fields they came from.
+func validateCoordinateFields(coordinates models.Coordinates, latitudeField string, longitudeField string) error {
+	// NaN fails every comparison, so it's checked explicitly, while infinities are out of the ranges.
+	var errs []error
+	if math.IsNaN(coordinates.Latitude) || coordinates.Latitude < -90 || coordinates.Latitude > 90 {
+		errs = append(errs, &ValidationError{Field: latitudeField, Message: "should be between -90 and 90"})
+	}
+	if math.IsNaN(coordinates.Longitude) || coordinates.Longitude < -180 || coordinates.Longitude > 180 {
+		errs = append(errs, &ValidationError{Field: longitudeField, Message: "should be between -180 and 180"})
+	}
+	return errors.Join(errs...)
+}
+
+func NewWeatherService(logger *slog.Logger, provider providers.WeatherProvider) *WeatherService {
+	return &WeatherService{
+		logger:   logger,
+		provider: provider,
+	}
+}
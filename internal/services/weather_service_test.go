@@ -0,0 +1,89 @@
+package services
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/maxdikun/weatherapp/internal/models"
+)
+
+func TestNormalizeHourlyForecast(t *testing.T) {
+	now := time.Date(2025, 6, 1, 10, 30, 0, 0, time.UTC)
+	hour := func(h int) time.Time { return time.Date(2025, 6, 1, h, 0, 0, 0, time.UTC) }
+
+	forecast := []models.HourlyForecast{
+		{Time: hour(12), Temperature: 12},
+		{Time: hour(9), Temperature: 9},
+		{Time: hour(10), Temperature: 10, Humidity: 120, PrecipitationProbability: -5, Precipitation: -1, WindDirection: -90},
+		{Time: hour(11), Temperature: 11},
+		{Time: hour(11), Temperature: 99},
+		{Time: hour(13), Temperature: 13},
+	}
+	original := slices.Clone(forecast)
+
+	result := normalizeHourlyForecast(forecast, now, 3)
+
+	var times []time.Time
+	for _, f := range result {
+		times = append(times, f.Time)
+	}
+	// The past hour is dropped, the current one is kept, duplicates keep the first value.
+	if want := []time.Time{hour(10), hour(11), hour(12)}; !slices.Equal(times, want) {
+		t.Errorf("times = %v, want %v", times, want)
+	}
+	if result[1].Temperature != 11 {
+		t.Errorf("duplicate hour Temperature = %v, want 11", result[1].Temperature)
+	}
+
+	want := models.HourlyForecast{Time: hour(10), Temperature: 10, Humidity: 100, WindDirection: 270}
+	if result[0] != want {
+		t.Errorf("result[0] = %+v, want %+v", result[0], want)
+	}
+
+	// The forecast can be shared through the cache, so it should be left as is.
+	if !slices.Equal(forecast, original) {
+		t.Errorf("forecast was modified: %+v", forecast)
+	}
+}
+
+func TestNormalizeDailyForecast(t *testing.T) {
+	now := time.Date(2025, 6, 2, 23, 0, 0, 0, time.UTC)
+	day := func(d int) time.Time { return time.Date(2025, 6, d, 0, 0, 0, 0, time.UTC) }
+
+	forecast := []models.DailyForecast{
+		{Date: day(4), TemperatureMin: 14, TemperatureMax: 20},
+		{Date: day(1), TemperatureMin: 11, TemperatureMax: 20},
+		{Date: day(2).Add(5 * time.Hour), TemperatureMin: 25, TemperatureMax: 12, WindDirection: 720},
+		{Date: day(3), TemperatureMin: 13, TemperatureMax: 20},
+		{Date: day(3), TemperatureMin: 99, TemperatureMax: 99},
+	}
+	original := slices.Clone(forecast)
+
+	result := normalizeDailyForecast(forecast, now, 16)
+
+	var dates []time.Time
+	for _, f := range result {
+		dates = append(dates, f.Date)
+	}
+	if want := []time.Time{day(2), day(3), day(4)}; !slices.Equal(dates, want) {
+		t.Errorf("dates = %v, want %v", dates, want)
+	}
+
+	// Swapped temperatures are put in order and the date is truncated to the start of the day.
+	want := models.DailyForecast{Date: day(2), TemperatureMin: 12, TemperatureMax: 25, WindDirection: 0}
+	if result[0] != want {
+		t.Errorf("result[0] = %+v, want %+v", result[0], want)
+	}
+	if result[1].TemperatureMin != 13 {
+		t.Errorf("duplicate day TemperatureMin = %v, want 13", result[1].TemperatureMin)
+	}
+
+	if limited := normalizeDailyForecast(forecast, now, 2); len(limited) != 2 {
+		t.Errorf("len(normalizeDailyForecast(2)) = %d, want 2", len(limited))
+	}
+
+	if !slices.Equal(forecast, original) {
+		t.Errorf("forecast was modified: %+v", forecast)
+	}
+}
@@ -6,14 +6,29 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"net/netip"
 	"os"
 	"os/signal"
+	"strings"
 	"time"
+	// The image has no system time zone database, which is needed for time zones of the users.
+	_ "time/tzdata"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/redis/go-redis/v9"
 
 	"github.com/maxdikun/weatherapp/internal/handlers"
+	"github.com/maxdikun/weatherapp/internal/notifications"
+	"github.com/maxdikun/weatherapp/internal/notifications/email"
+	"github.com/maxdikun/weatherapp/internal/notifications/webhook"
+	"github.com/maxdikun/weatherapp/internal/providers"
+	"github.com/maxdikun/weatherapp/internal/providers/broadcast"
+	"github.com/maxdikun/weatherapp/internal/providers/cache"
+	"github.com/maxdikun/weatherapp/internal/providers/composite"
+	"github.com/maxdikun/weatherapp/internal/providers/nominatim"
+	"github.com/maxdikun/weatherapp/internal/providers/openmeteo"
+	"github.com/maxdikun/weatherapp/internal/providers/openweathermap"
+	"github.com/maxdikun/weatherapp/internal/repositories"
 	"github.com/maxdikun/weatherapp/internal/repositories/postgres"
 	redisRepo "github.com/maxdikun/weatherapp/internal/repositories/redis"
 	"github.com/maxdikun/weatherapp/internal/services"
@@ -58,6 +73,34 @@ func main() {
 
 	userRepository := postgres.NewUserRepository(postgresPool)
 	sessionRepository := redisRepo.NewSessionRepository(redisClient)
+	loginAttemptRepository := redisRepo.NewLoginAttemptRepository(redisClient)
+
+	keyring, err := newKeyring(cfg)
+	if err != nil {
+		logger.Error("Failed to create access token keyring", "err", err)
+		return
+	}
+
+	passwordPolicy, err := newPasswordPolicy(cfg)
+	if err != nil {
+		logger.Error("Failed to create password policy", "err", err)
+		return
+	}
+
+	passwordHasher, err := newPasswordHasher(cfg)
+	if err != nil {
+		logger.Error("Failed to create password hasher", "err", err)
+		return
+	}
+
+	notificationChannels, err := newNotificationChannels(cfg)
+	if err != nil {
+		logger.Error("Failed to create notification channels", "err", err)
+		return
+	}
+
+	notificationRepository := postgres.NewNotificationRepository(postgresPool)
+	notificationService := services.NewNotificationService(logger, notificationRepository)
 
 	userService := services.NewUserService(
 		logger,
@@ -65,16 +108,130 @@ func main() {
 		sessionRepository,
 		cfg.Domain.SessionDuration,
 		cfg.Domain.AccessTokenDuration,
-		[]byte(cfg.Domain.AcessTokenSecret),
+		keyring,
+		cfg.Domain.AccessTokenIssuer,
+		cfg.Domain.AccessTokenAudience,
+		passwordPolicy,
+		passwordHasher,
+		services.NewLoginGuard(
+			loginAttemptRepository,
+			cfg.Domain.LoginAttemptWindow,
+			cfg.Domain.LoginMaxAttemptsPerUser,
+			cfg.Domain.LoginMaxAttemptsPerIP,
+			cfg.Domain.LoginLockout,
+			cfg.Domain.LoginMaxLockout,
+		),
+		notificationService,
+	)
+
+	rateLimiter, err := newRateLimiter(cfg, redisRepo.NewRateLimitRepository(redisClient))
+	if err != nil {
+		logger.Error("Failed to create rate limiter", "err", err)
+		return
+	}
+
+	eventRepository := redisRepo.NewEventRepository(logger, redisClient)
+
+	weatherProvider, err := newWeatherProvider(logger, cfg)
+	if err != nil {
+		logger.Error("Failed to create weather provider", "err", err)
+		return
+	}
+	// The updates are published below the cache, so they are published when the cached data is refreshed.
+	weatherProvider = broadcast.NewProvider(logger, weatherProvider, eventRepository)
+	if cfg.Weather.Cache.Enabled {
+		weatherProvider = cache.NewProvider(
+			logger,
+			weatherProvider,
+			redisRepo.NewCacheRepository(redisClient),
+			cfg.Weather.Cache.Precision,
+			cache.TTL{
+				Current: cfg.Weather.Cache.CurrentTTL,
+				Hourly:  cfg.Weather.Cache.HourlyTTL,
+				Daily:   cfg.Weather.Cache.DailyTTL,
+				Stale:   cfg.Weather.Cache.StaleTTL,
+			},
+			cfg.Weather.Timeout,
+		)
+	}
+
+	var geocoder providers.Geocoder = nominatim.NewClient(
+		&http.Client{Timeout: cfg.Geo.Nominatim.Timeout},
+		cfg.Geo.Nominatim.BaseURL,
+		cfg.Geo.Nominatim.UserAgent,
 	)
+	if cfg.Geo.Cache.Enabled {
+		geocoder = cache.NewGeocoder(
+			logger,
+			geocoder,
+			redisRepo.NewCacheRepository(redisClient),
+			cfg.Geo.Cache.Precision,
+			cfg.Geo.Cache.TTL,
+			cfg.Geo.Cache.StaleTTL,
+			cfg.Geo.Nominatim.Timeout,
+		)
+	}
 
-	m := handlers.SetupHandlers(userService)
+	weatherService := services.NewWeatherService(logger, weatherProvider)
+	geoService := services.NewGeoService(logger, geocoder)
+	locationService := services.NewLocationService(
+		logger,
+		postgres.NewLocationRepository(postgresPool),
+		geoService,
+		cfg.Domain.MaxLocationsPerUser,
+	)
+
+	alertRepository := postgres.NewAlertRepository(postgresPool)
+	alertService := services.NewAlertService(
+		logger,
+		alertRepository,
+		locationService,
+		cfg.Domain.MaxAlertSubscriptionsPerUser,
+	)
+
+	streamService := services.NewStreamService(
+		logger,
+		eventRepository,
+		locationService,
+		weatherService,
+		cfg.Weather.Cache.Precision,
+		cfg.Stream.BufferSize,
+		cfg.Stream.RefreshInterval,
+	)
+
+	trustedProxies, err := parseTrustedProxies(cfg.HTTP.TrustedProxies)
+	if err != nil {
+		logger.Error("Failed to parse trusted proxies", "err", err)
+		return
+	}
+
+	m := handlers.SetupHandlers(
+		logger,
+		userService,
+		weatherService,
+		locationService,
+		geoService,
+		alertService,
+		notificationService,
+		streamService,
+		rateLimiter,
+		trustedProxies,
+	)
 
 	server := &http.Server{
 		Handler: m,
 		Addr:    fmt.Sprintf(":%d", cfg.HTTP.Port),
 	}
 
+	// Shutdown doesn't interrupt active requests, so the streams are closed when it starts.
+	streamsCtx, stopStreams := context.WithCancel(context.Background())
+	streamsDone := make(chan struct{})
+	server.RegisterOnShutdown(stopStreams)
+	go func() {
+		defer close(streamsDone)
+		streamService.Run(streamsCtx)
+	}()
+
 	go func() {
 		err := server.ListenAndServe()
 		if err != nil && !errors.Is(err, http.ErrServerClosed) {
@@ -83,6 +240,48 @@ func main() {
 		}
 	}()
 
+	evaluatorCtx, stopEvaluator := context.WithCancel(context.Background())
+	evaluatorDone := make(chan struct{})
+	if cfg.Alerts.Enabled {
+		evaluator := services.NewAlertEvaluator(
+			logger,
+			alertRepository,
+			eventRepository,
+			weatherService,
+			cfg.Alerts.Interval,
+			cfg.Alerts.Concurrency,
+		)
+		go func() {
+			defer close(evaluatorDone)
+			evaluator.Run(evaluatorCtx)
+		}()
+	} else {
+		close(evaluatorDone)
+	}
+
+	dispatcherCtx, stopDispatcher := context.WithCancel(context.Background())
+	dispatcherDone := make(chan struct{})
+	if cfg.Notifications.Enabled {
+		dispatcher := services.NewNotificationDispatcher(
+			logger,
+			notificationRepository,
+			notificationChannels,
+			cfg.Notifications.Interval,
+			cfg.Notifications.Lease,
+			cfg.Notifications.BatchSize,
+			cfg.Notifications.Concurrency,
+			cfg.Notifications.MaxAttempts,
+			cfg.Notifications.RetryBackoff,
+			cfg.Notifications.MaxRetryBackoff,
+		)
+		go func() {
+			defer close(dispatcherDone)
+			dispatcher.Run(dispatcherCtx)
+		}()
+	} else {
+		close(dispatcherDone)
+	}
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt)
 	<-quit
@@ -94,6 +293,272 @@ func main() {
 		logger.Error("Graceful shutdown of HTTP Server failed", "err", err)
 		os.Exit(1)
 	}
+	<-streamsDone
+
+	stopEvaluator()
+	<-evaluatorDone
+	// Interrupted deliveries are retried by the next dispatcher after their lease expires.
+	stopDispatcher()
+	<-dispatcherDone
 
 	logger.Info("Server is gracefully stopped")
 }
+
+func newKeyring(cfg Config) (*services.Keyring, error) {
+	switch cfg.Domain.AccessTokenAlgorithm {
+	case "HS256":
+		return newHMACKeyring(cfg)
+	case "RS256", "EdDSA":
+		return newAsymmetricKeyring(cfg)
+	default:
+		return nil, fmt.Errorf("unsupported access token algorithm '%s'", cfg.Domain.AccessTokenAlgorithm)
+	}
+}
+
+func newHMACKeyring(cfg Config) (*services.Keyring, error) {
+	if len(cfg.Domain.AccessTokenKeys) == 0 {
+		signing, err := services.NewHMACKey(cfg.Domain.AccessTokenKeyId, []byte(cfg.Domain.AcessTokenSecret))
+		if err != nil {
+			return nil, err
+		}
+		return services.NewKeyring(signing)
+	}
+
+	secret, ok := cfg.Domain.AccessTokenKeys[cfg.Domain.AccessTokenKeyId]
+	if !ok {
+		return nil, fmt.Errorf("signing key '%s' is not present in the access token keys", cfg.Domain.AccessTokenKeyId)
+	}
+
+	signing, err := services.NewHMACKey(cfg.Domain.AccessTokenKeyId, []byte(secret))
+	if err != nil {
+		return nil, err
+	}
+
+	var verifying []services.SigningKey
+	for id, secret := range cfg.Domain.AccessTokenKeys {
+		if id == cfg.Domain.AccessTokenKeyId {
+			continue
+		}
+		key, err := services.NewHMACKey(id, []byte(secret))
+		if err != nil {
+			return nil, err
+		}
+		verifying = append(verifying, key)
+	}
+
+	return services.NewKeyring(signing, verifying...)
+}
+
+func newAsymmetricKeyring(cfg Config) (*services.Keyring, error) {
+	data, err := os.ReadFile(cfg.Domain.AccessTokenPrivateKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read private key: %w", err)
+	}
+
+	signing, err := services.ParsePrivateKeyPEM(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+
+	if signing.Method.Alg() != cfg.Domain.AccessTokenAlgorithm {
+		return nil, fmt.Errorf(
+			"private key is for '%s', but '%s' is configured",
+			signing.Method.Alg(),
+			cfg.Domain.AccessTokenAlgorithm,
+		)
+	}
+
+	var verifying []services.SigningKey
+	for _, path := range cfg.Domain.AccessTokenPublicKeyFiles {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read public key: %w", err)
+		}
+
+		key, err := services.ParsePublicKeyPEM(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse public key '%s': %w", path, err)
+		}
+		verifying = append(verifying, key)
+	}
+
+	return services.NewKeyring(signing, verifying...)
+}
+
+func newPasswordPolicy(cfg Config) (*services.PasswordPolicy, error) {
+	rules := []services.PasswordRule{
+		services.MinLengthRule(cfg.Domain.PasswordMinLength),
+		services.MaxBytesRule(72),
+		services.NoLoginRule,
+	}
+
+	if cfg.Domain.PasswordRequireLower {
+		rules = append(rules, services.LowercaseRule)
+	}
+	if cfg.Domain.PasswordRequireUpper {
+		rules = append(rules, services.UppercaseRule)
+	}
+	if cfg.Domain.PasswordRequireDigit {
+		rules = append(rules, services.DigitRule)
+	}
+	if cfg.Domain.PasswordRequireSymbol {
+		rules = append(rules, services.SymbolRule)
+	}
+
+	if cfg.Domain.PasswordBreachedListFile != "" {
+		f, err := os.Open(cfg.Domain.PasswordBreachedListFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open breached password list: %w", err)
+		}
+		defer f.Close()
+
+		breached, err := services.ReadBreachedPasswords(f)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read breached password list: %w", err)
+		}
+		rules = append(rules, services.BreachedPasswordRule(breached))
+	}
+
+	return services.NewPasswordPolicy(rules...), nil
+}
+
+func newPasswordHasher(cfg Config) (services.PasswordHasher, error) {
+	argon2id := &services.Argon2idHasher{
+		Memory:      cfg.Domain.PasswordArgon2Memory,
+		Iterations:  cfg.Domain.PasswordArgon2Iterations,
+		Parallelism: cfg.Domain.PasswordArgon2Parallelism,
+		SaltLength:  16,
+		KeyLength:   32,
+	}
+	bcrypt := &services.BcryptHasher{Cost: cfg.Domain.PasswordBcryptCost}
+
+	// Both are validated, because the hashes of the fallback one are still verified.
+	if err := errors.Join(argon2id.Validate(), bcrypt.Validate()); err != nil {
+		return nil, err
+	}
+
+	switch cfg.Domain.PasswordHashAlgorithm {
+	case "argon2id":
+		return services.NewFallbackHasher(argon2id, bcrypt), nil
+	case "bcrypt":
+		return services.NewFallbackHasher(bcrypt, argon2id), nil
+	default:
+		return nil, fmt.Errorf("unsupported password hash algorithm '%s'", cfg.Domain.PasswordHashAlgorithm)
+	}
+}
+
+func newNotificationChannels(cfg Config) ([]notifications.Channel, error) {
+	channels := []notifications.Channel{
+		webhook.NewChannel(
+			&http.Client{
+				Transport: webhook.NewTransport(cfg.Notifications.Webhook.AllowPrivateNetworks),
+				Timeout:   cfg.Notifications.Webhook.Timeout,
+			},
+			cfg.Notifications.Webhook.UserAgent,
+			cfg.Notifications.Webhook.Retries,
+			cfg.Notifications.Webhook.RetryBackoff,
+		),
+	}
+
+	if cfg.Notifications.SMTP.Host != "" {
+		channel, err := email.NewChannel(
+			cfg.Notifications.SMTP.Host,
+			cfg.Notifications.SMTP.Port,
+			cfg.Notifications.SMTP.Username,
+			cfg.Notifications.SMTP.Password,
+			cfg.Notifications.SMTP.From,
+			cfg.Notifications.SMTP.Timeout,
+		)
+		if err != nil {
+			return nil, err
+		}
+		channels = append(channels, channel)
+	}
+
+	return channels, nil
+}
+
+func newRateLimiter(cfg Config, buckets repositories.RateLimitRepository) (*services.RateLimiter, error) {
+	if !cfg.RateLimit.Enabled {
+		return nil, nil
+	}
+
+	perIP, err := services.ParseRateLimit(cfg.RateLimit.PerIP)
+	if err != nil {
+		return nil, err
+	}
+
+	perUser, err := services.ParseRateLimit(cfg.RateLimit.PerUser)
+	if err != nil {
+		return nil, err
+	}
+
+	perRoute := make(map[string]services.RateLimit, len(cfg.RateLimit.PerRoute))
+	for route, limit := range cfg.RateLimit.PerRoute {
+		perRoute[route], err = services.ParseRateLimit(limit)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return services.NewRateLimiter(buckets, perIP, perUser, perRoute), nil
+}
+
+// parseTrustedProxies parses the CIDRs, a single address is a prefix of its full length.
+func parseTrustedProxies(proxies []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(proxies))
+	for _, proxy := range proxies {
+		proxy = strings.TrimSpace(proxy)
+		if !strings.Contains(proxy, "/") {
+			addr, err := netip.ParseAddr(proxy)
+			if err != nil {
+				return nil, fmt.Errorf("invalid trusted proxy '%s': %w", proxy, err)
+			}
+			prefixes = append(prefixes, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy '%s': %w", proxy, err)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+	return prefixes, nil
+}
+
+func newWeatherProvider(logger *slog.Logger, cfg Config) (providers.WeatherProvider, error) {
+	httpClient := &http.Client{Timeout: cfg.Weather.Timeout}
+
+	var sources []composite.Source
+	for _, name := range cfg.Weather.Providers {
+		switch name {
+		case "openweathermap":
+			sources = append(sources, composite.Source{
+				Name:     name,
+				Provider: openweathermap.NewClient(httpClient, cfg.Weather.OpenWeatherMap.BaseURL, cfg.Weather.OpenWeatherMap.APIKey),
+				Timeout:  cfg.Weather.OpenWeatherMap.Timeout,
+			})
+		case "openmeteo":
+			sources = append(sources, composite.Source{
+				Name:     name,
+				Provider: openmeteo.NewClient(httpClient, cfg.Weather.OpenMeteo.BaseURL, cfg.Weather.OpenMeteo.APIKey),
+				Timeout:  cfg.Weather.OpenMeteo.Timeout,
+			})
+		default:
+			return nil, fmt.Errorf("unsupported weather provider '%s'", name)
+		}
+	}
+
+	if len(sources) == 0 {
+		return nil, errors.New("no weather providers are configured")
+	}
+
+	return composite.NewProvider(
+		logger,
+		sources,
+		cfg.Weather.BreakerThreshold,
+		cfg.Weather.BreakerCooldown,
+		cfg.Weather.Blend,
+	), nil
+}